@@ -1,22 +1,35 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"strings"
 	"time"
+	_ "time/tzdata" // ユーザーのタイムゾーン解決用（tzdataのないコンテナでも動作させる）
 
 	"github.com/labstack/echo/v4"
 	echoMw "github.com/labstack/echo/v4/middleware"
 
+	"github.com/ryusuke/task_app_layerx/internal/domain"
 	"github.com/ryusuke/task_app_layerx/internal/infrastructure/clock"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mail"
 	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql"
 	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/repository"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/notification"
 	"github.com/ryusuke/task_app_layerx/internal/presentation/handler"
 	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
 	authuc "github.com/ryusuke/task_app_layerx/internal/usecase/auth"
+	calendaruc "github.com/ryusuke/task_app_layerx/internal/usecase/calendar"
+	groupuc "github.com/ryusuke/task_app_layerx/internal/usecase/group"
+	idempotencyuc "github.com/ryusuke/task_app_layerx/internal/usecase/idempotency"
+	slauc "github.com/ryusuke/task_app_layerx/internal/usecase/sla"
 	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	viewuc "github.com/ryusuke/task_app_layerx/internal/usecase/view"
 	"github.com/ryusuke/task_app_layerx/pkg/auth"
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
 	"github.com/ryusuke/task_app_layerx/pkg/hash"
+	"github.com/ryusuke/task_app_layerx/pkg/signedtoken"
 )
 
 func main() {
@@ -31,11 +44,96 @@ func main() {
 		log.Fatal("JWT_SECRET environment variable is required")
 	}
 
+	// ページングのカーソルの署名鍵（未設定の場合はJWT_SECRETを使う）
+	cursorSecret := os.Getenv("CURSOR_SECRET")
+	if cursorSecret == "" {
+		cursorSecret = jwtSecret
+	}
+
+	// Idempotency-Keyの処理結果を保存する期間
+	idempotencyTTL := 24 * time.Hour
+	if v := os.Getenv("IDEMPOTENCY_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("invalid IDEMPOTENCY_TTL: %q", v)
+		}
+		idempotencyTTL = parsed
+	}
+
 	jwtIssuer := os.Getenv("JWT_ISSUER")
 	if jwtIssuer == "" {
 		jwtIssuer = "task_app_layerx"
 	}
 
+	// アクセストークン（JWT）とリフレッシュトークンの有効期間
+	accessTokenTTL := 15 * time.Minute
+	if v := os.Getenv("ACCESS_TOKEN_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("invalid ACCESS_TOKEN_TTL: %q", v)
+		}
+		accessTokenTTL = parsed
+	}
+	refreshTokenTTL := 30 * 24 * time.Hour
+	if v := os.Getenv("REFRESH_TOKEN_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("invalid REFRESH_TOKEN_TTL: %q", v)
+		}
+		refreshTokenTTL = parsed
+	}
+
+	// パスワード再設定リンクの有効期間
+	passwordResetTTL := time.Hour
+	if v := os.Getenv("PASSWORD_RESET_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("invalid PASSWORD_RESET_TTL: %q", v)
+		}
+		passwordResetTTL = parsed
+	}
+
+	// メールアドレス確認リンクの有効期間と署名鍵（署名鍵が未設定の場合はJWT_SECRETを使う）
+	// 署名には用途を含めるため、同じ鍵でもページングのカーソルなど他の署名付きトークンとは区別される
+	emailVerificationTTL := 24 * time.Hour
+	if v := os.Getenv("EMAIL_VERIFICATION_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("invalid EMAIL_VERIFICATION_TTL: %q", v)
+		}
+		emailVerificationTTL = parsed
+	}
+	emailVerificationSecret := os.Getenv("EMAIL_VERIFICATION_SECRET")
+	if emailVerificationSecret == "" {
+		emailVerificationSecret = jwtSecret
+	}
+
+	// メールアドレス未確認のユーザーに課す制限（none / no_assign / no_login）
+	unverifiedUserPolicy := domain.UnverifiedUserPolicyNoAssign
+	if v := os.Getenv("UNVERIFIED_USER_POLICY"); v != "" {
+		unverifiedUserPolicy = domain.UnverifiedUserPolicy(v)
+		if !unverifiedUserPolicy.IsValid() {
+			log.Fatalf("invalid UNVERIFIED_USER_POLICY: %q", v)
+		}
+	}
+
+	// メールに記載するリンクのベースURL（フロントエンドのURL）
+	appBaseURL := strings.TrimSuffix(os.Getenv("APP_BASE_URL"), "/")
+	if appBaseURL == "" {
+		appBaseURL = "http://localhost:5173"
+	}
+
+	// メールの送信元と送信方法（SMTP_ADDRが未設定の場合はMAIL_OUTBOX_DIRに.emlファイルとして書き出す）
+	mailFrom := os.Getenv("MAIL_FROM")
+	if mailFrom == "" {
+		mailFrom = "no-reply@task-app.local"
+	}
+	smtpAddr := os.Getenv("SMTP_ADDR")
+	mailOutboxDir := os.Getenv("MAIL_OUTBOX_DIR")
+	if mailOutboxDir == "" {
+		mailOutboxDir = "outbox"
+	}
+
 	// DB接続
 	db, err := mysql.NewDBFromDSN(dbDSN)
 	if err != nil {
@@ -53,34 +151,146 @@ func main() {
 	userRepo := repository.NewUserRepository()
 	taskRepo := repository.NewTaskRepository()
 	taskAssigneeRepo := repository.NewTaskAssigneeRepository()
+	taskGroupAssigneeRepo := repository.NewTaskGroupAssigneeRepository()
+	groupRepo := repository.NewGroupRepository()
+	groupMemberRepo := repository.NewGroupMemberRepository()
+	taskShareRepo := repository.NewTaskShareRepository()
+	taskDependencyRepo := repository.NewTaskDependencyRepository()
+	slaPolicyRepo := repository.NewSLAPolicyRepository()
+	calendarTokenRepo := repository.NewCalendarTokenRepository()
+	taskSearchIndex := repository.NewTaskSearchIndex()
+	savedViewRepo := repository.NewSavedViewRepository()
+	savedViewShareRepo := repository.NewSavedViewShareRepository()
+	idempotencyRepo := repository.NewIdempotencyRepository()
+	taskRevisionRepo := repository.NewTaskRevisionRepository()
+	taskStatusTransitionRepo := repository.NewTaskStatusTransitionRepository()
+	sessionRepo := repository.NewSessionRepository()
+	refreshTokenRepo := repository.NewRefreshTokenRepository()
+	passwordResetRepo := repository.NewPasswordResetTokenRepository()
 
 	// pkg層の初期化
 	realClock := clock.New()
-	jwtService := auth.NewJWTService(jwtSecret, jwtIssuer, 24*time.Hour, func() time.Time {
+	jwtService := auth.NewJWTService(jwtSecret, jwtIssuer, accessTokenTTL, func() time.Time {
 		return realClock.Now()
 	})
 	bcryptService := hash.NewBcryptService(12)
+	notifier := notification.NewLogNotifier()
+	var mailSender domain.MailSender
+	if smtpAddr != "" {
+		mailSender = mail.NewSMTPSender(smtpAddr, mailFrom, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), realClock)
+	} else {
+		mailSender = mail.NewOutboxSender(mailOutboxDir, mailFrom, realClock)
+	}
 
 	// UseCase層の初期化
 	authUseCase := authuc.NewAuthUseCase(
 		userRepo,
+		sessionRepo,
+		refreshTokenRepo,
+		passwordResetRepo,
+		mailSender,
 		txManager,
 		realClock,
 		jwtService,
 		bcryptService,
+		signedtoken.NewSigner(emailVerificationSecret, authuc.EmailVerificationPurpose),
+		authuc.Config{
+			RefreshTokenTTL:      refreshTokenTTL,
+			PasswordResetTTL:     passwordResetTTL,
+			EmailVerificationTTL: emailVerificationTTL,
+			UnverifiedUserPolicy: unverifiedUserPolicy,
+			AppBaseURL:           appBaseURL,
+		},
 	)
 
 	taskUseCase := taskuc.NewTaskUseCase(
 		taskRepo,
 		taskAssigneeRepo,
+		taskGroupAssigneeRepo,
+		groupRepo,
+		groupMemberRepo,
+		taskShareRepo,
+		taskDependencyRepo,
+		slaPolicyRepo,
+		taskSearchIndex,
+		taskRevisionRepo,
+		taskStatusTransitionRepo,
+		userRepo,
+		txManager,
+		realClock,
+		unverifiedUserPolicy,
+	)
+
+	groupUseCase := groupuc.NewGroupUseCase(
+		groupRepo,
+		groupMemberRepo,
+		userRepo,
+		txManager,
+		realClock,
+		unverifiedUserPolicy,
+	)
+
+	slaUseCase := slauc.NewSLAUseCase(
+		slaPolicyRepo,
+		taskRepo,
+		taskAssigneeRepo,
+		taskGroupAssigneeRepo,
+		taskRevisionRepo,
+		userRepo,
+		notifier,
+		txManager,
+		realClock,
+	)
+
+	viewUseCase := viewuc.NewViewUseCase(
+		savedViewRepo,
+		savedViewShareRepo,
 		userRepo,
+		taskUseCase,
 		txManager,
 		realClock,
 	)
 
+	calendarUseCase := calendaruc.NewCalendarUseCase(
+		calendarTokenRepo,
+		taskRepo,
+		userRepo,
+		txManager,
+		realClock,
+	)
+
+	idempotencyUseCase := idempotencyuc.NewIdempotencyUseCase(
+		idempotencyRepo,
+		txManager,
+		realClock,
+		idempotencyTTL,
+	)
+
+	// SLAエスカレーションの定期実行
+	slaInterval := 5 * time.Minute
+	if v := os.Getenv("SLA_CHECK_INTERVAL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid SLA_CHECK_INTERVAL: %v", err)
+		}
+		slaInterval = parsed
+	}
+	go runSLAEscalation(slaUseCase, slaInterval)
+
+	// 有効期限が切れた冪等キーの定期削除
+	go runIdempotencyCleanup(idempotencyUseCase, time.Hour)
+
+	// 有効期限が切れたセッション・リフレッシュトークン・パスワード再設定トークンの定期削除
+	go runSessionCleanup(authUseCase, time.Hour)
+
 	// Handler層の初期化
-	authHandler := handler.NewAuthHandler(authUseCase)
-	taskHandler := handler.NewTaskHandler(taskUseCase)
+	authHandler := handler.NewAuthHandler(authUseCase, idempotencyUseCase)
+	cursorSigner := cursor.NewSigner(cursorSecret)
+	taskHandler := handler.NewTaskHandler(taskUseCase, cursorSigner)
+	viewHandler := handler.NewViewHandler(viewUseCase, cursorSigner)
+	groupHandler := handler.NewGroupHandler(groupUseCase)
+	slaHandler := handler.NewSLAHandler(slaUseCase)
+	calendarHandler := handler.NewCalendarHandler(calendarUseCase)
 
 	// Echoの設定
 	e := echo.New()
@@ -88,34 +298,118 @@ func main() {
 	// ミドルウェアの設定
 	e.Use(echoMw.Recover())
 	e.Use(echoMw.Logger())
-	e.Use(echoMw.CORS())
+	// ETag（If-Matchで使う）とIdempotent-Replayedをブラウザのスクリプトから読めるようにする
+	e.Use(echoMw.CORSWithConfig(echoMw.CORSConfig{
+		ExposeHeaders: []string{"ETag", "Idempotent-Replayed"},
+	}))
 
 	// ルーティングの設定
 	api := e.Group("/api/v1")
 
+	// Idempotency-Keyヘッダーによるリトライ時の重複実行の防止（作成系のPOSTに適用する）
+	// レスポンスをそのまま保存するため、トークンを返す認証系のエンドポイントには適用しない
+	// （ユーザー登録はAuthHandlerがトークンを保存せずにIdempotency-Keyを処理する）
+	idempotency := middleware.IdempotencyMiddleware(idempotencyUseCase)
+
 	// 認証不要なエンドポイント
 	auth := api.Group("/auth")
 	auth.POST("/signup", authHandler.Signup)
 	auth.POST("/login", authHandler.Login)
+	auth.POST("/refresh", authHandler.Refresh)
+	auth.POST("/password/forgot", authHandler.ForgotPassword)
+	auth.POST("/password/reset", authHandler.ResetPassword)
+	auth.POST("/email/verify", authHandler.VerifyEmail)
+	auth.POST("/email/resend", authHandler.ResendVerificationEmail)
+
+	// カレンダーアプリ向けicsフィード（URLの秘密トークンで認証）
+	api.GET("/calendar/feed/:token", calendarHandler.GetFeed)
 
 	// 認証が必要なエンドポイント
-	jwtMiddleware := middleware.JWTMiddleware(jwtService, userRepo, executor)
+	jwtMiddleware := middleware.JWTMiddleware(jwtService, userRepo, sessionRepo, executor)
 
 	authProtected := api.Group("/auth")
 	authProtected.Use(jwtMiddleware)
 	authProtected.POST("/logout", authHandler.Logout)
+	authProtected.GET("/sessions", authHandler.ListSessions)
+	authProtected.POST("/sessions/revoke-others", authHandler.RevokeOtherSessions)
+	authProtected.DELETE("/sessions/:id", authHandler.RevokeSession)
 
 	users := api.Group("/users")
 	users.Use(jwtMiddleware)
 	users.GET("", authHandler.GetUsers)
+	users.GET("/me", authHandler.GetMe)
+	users.PATCH("/me", authHandler.UpdateMe)
 
 	tasks := api.Group("/tasks")
 	tasks.Use(jwtMiddleware)
 	tasks.GET("", taskHandler.ListTasks)
-	tasks.POST("", taskHandler.CreateTask)
+	tasks.GET("/export", taskHandler.ExportTasks)
+	tasks.GET("/search", taskHandler.SearchTasks)
+	tasks.POST("", taskHandler.CreateTask, idempotency)
+	tasks.POST("/bulk", taskHandler.BulkUpdateTasks, idempotency)
+	tasks.POST("/import", taskHandler.ImportTasks, idempotency)
+	tasks.POST("/quick", taskHandler.QuickAddTask, idempotency)
 	tasks.GET("/:id", taskHandler.GetTask)
 	tasks.PATCH("/:id", taskHandler.UpdateTask)
 	tasks.DELETE("/:id", taskHandler.DeleteTask)
+	tasks.GET("/:id/revisions", taskHandler.ListRevisions)
+	tasks.GET("/:id/revisions/diff", taskHandler.DiffRevisions)
+	tasks.GET("/:id/revisions/:rev", taskHandler.GetRevision)
+	tasks.POST("/:id/revisions/:rev/revert", taskHandler.RevertTask)
+	tasks.GET("/:id/shares", taskHandler.ListShares)
+	tasks.PUT("/:id/shares/:userId", taskHandler.ShareTask)
+	tasks.DELETE("/:id/shares/:userId", taskHandler.RevokeShare)
+	tasks.POST("/:id/dependencies", taskHandler.AddDependency, idempotency)
+	tasks.DELETE("/:id/dependencies/:dependsOnId", taskHandler.RemoveDependency)
+
+	timeline := api.Group("/timeline")
+	timeline.Use(jwtMiddleware)
+	timeline.GET("", taskHandler.GetTimeline)
+
+	stats := api.Group("/stats")
+	stats.Use(jwtMiddleware)
+	stats.GET("", taskHandler.GetStats)
+
+	analytics := api.Group("/analytics")
+	analytics.Use(jwtMiddleware)
+	analytics.GET("/lead-time", taskHandler.GetLeadTime)
+	analytics.GET("/cycle-time", taskHandler.GetCycleTime)
+	analytics.GET("/throughput", taskHandler.GetThroughput)
+	analytics.GET("/status-snapshots", taskHandler.GetStatusSnapshots)
+
+	reports := api.Group("/reports")
+	reports.Use(jwtMiddleware)
+	reports.GET("/workload", taskHandler.GetWorkload)
+
+	views := api.Group("/views")
+	views.Use(jwtMiddleware)
+	views.GET("", viewHandler.ListViews)
+	views.POST("", viewHandler.CreateView, idempotency)
+	views.GET("/:id", viewHandler.GetView)
+	views.PATCH("/:id", viewHandler.UpdateView)
+	views.DELETE("/:id", viewHandler.DeleteView)
+	views.GET("/:id/tasks", viewHandler.ListViewTasks)
+	views.PUT("/:id/shares/:userId", viewHandler.ShareView)
+	views.DELETE("/:id/shares/:userId", viewHandler.RevokeViewShare)
+
+	calendar := api.Group("/calendar")
+	calendar.Use(jwtMiddleware)
+	calendar.POST("/token", calendarHandler.RegenerateToken)
+	calendar.DELETE("/token", calendarHandler.RevokeToken)
+
+	slaPolicies := api.Group("/sla-policies")
+	slaPolicies.Use(jwtMiddleware)
+	slaPolicies.GET("", slaHandler.ListPolicies)
+
+	groups := api.Group("/groups")
+	groups.Use(jwtMiddleware)
+	groups.GET("", groupHandler.ListGroups)
+	groups.POST("", groupHandler.CreateGroup, idempotency)
+	groups.GET("/:id", groupHandler.GetGroup)
+	groups.PATCH("/:id", groupHandler.UpdateGroup)
+	groups.DELETE("/:id", groupHandler.DeleteGroup)
+	groups.POST("/:id/members", groupHandler.AddMember, idempotency)
+	groups.DELETE("/:id/members/:userId", groupHandler.RemoveMember)
 
 	// サーバー起動
 	port := os.Getenv("APP_PORT")
@@ -128,3 +422,51 @@ func main() {
 		log.Fatalf("failed to start server: %v", err)
 	}
 }
+
+// runSLAEscalationは一定間隔でSLA違反が近いタスクをエスカレーションする
+func runSLAEscalation(slaUseCase *slauc.SLAUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		result, err := slaUseCase.EscalateTasks(context.Background())
+		if err != nil {
+			log.Printf("sla escalation failed: %v", err)
+		}
+		if result != nil && result.Escalated > 0 {
+			log.Printf("sla escalation: escalated=%d notified=%d", result.Escalated, result.Notified)
+		}
+	}
+}
+
+// runIdempotencyCleanupは一定間隔で有効期限が切れた冪等キーを削除する
+func runIdempotencyCleanup(idempotencyUseCase *idempotencyuc.IdempotencyUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := idempotencyUseCase.DeleteExpired(context.Background())
+		if err != nil {
+			log.Printf("idempotency cleanup failed: %v", err)
+		}
+		if deleted > 0 {
+			log.Printf("idempotency cleanup: deleted=%d", deleted)
+		}
+	}
+}
+
+// runSessionCleanupは一定間隔で有効期限が切れたセッション・リフレッシュトークンを削除する
+func runSessionCleanup(authUseCase *authuc.AuthUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := authUseCase.DeleteExpiredSessions(context.Background())
+		if err != nil {
+			log.Printf("session cleanup failed: %v", err)
+		}
+		if deleted > 0 {
+			log.Printf("session cleanup: deleted=%d", deleted)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+// CalendarTokenはカレンダーアプリ向けicsフィードのユーザーごとの秘密トークン
+// トークンそのものは発行時にのみ返し、DBにはハッシュのみ保存する
+type CalendarToken struct {
+	UserID    int64
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// NewCalendarTokenは新しいトークンを生成し、エンティティと平文のトークンを返す
+func NewCalendarToken(clock Clock, userID int64) (*CalendarToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return &CalendarToken{
+		UserID:    userID,
+		TokenHash: HashCalendarToken(token),
+		CreatedAt: clock.Now(),
+	}, token, nil
+}
+
+// HashCalendarTokenはトークンのSHA-256ハッシュ（16進数）を返す
+func HashCalendarToken(token string) string {
+	return hashToken(token)
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+// UnverifiedUserPolicyはメールアドレスを確認していないユーザーに課す制限
+type UnverifiedUserPolicy string
+
+const (
+	// UnverifiedUserPolicyNoneは制限しない
+	UnverifiedUserPolicyNone UnverifiedUserPolicy = "none"
+	// UnverifiedUserPolicyNoAssignはタスクにアサインできない（ログインはできる）
+	UnverifiedUserPolicyNoAssign UnverifiedUserPolicy = "no_assign"
+	// UnverifiedUserPolicyNoLoginはログインできず、タスクにもアサインできない
+	UnverifiedUserPolicyNoLogin UnverifiedUserPolicy = "no_login"
+)
+
+// IsValidは定義済みのポリシーかを判定
+func (p UnverifiedUserPolicy) IsValid() bool {
+	switch p {
+	case UnverifiedUserPolicyNone, UnverifiedUserPolicyNoAssign, UnverifiedUserPolicyNoLogin:
+		return true
+	}
+	return false
+}
+
+// CanLoginはユーザーがログイン（トークンの再発行を含む）できるかを判定
+func (p UnverifiedUserPolicy) CanLogin(user *User) bool {
+	return p != UnverifiedUserPolicyNoLogin || user.IsEmailVerified()
+}
+
+// CanBeAssignedはユーザーをタスクにアサインできるかを判定
+func (p UnverifiedUserPolicy) CanBeAssigned(user *User) bool {
+	if p != UnverifiedUserPolicyNoAssign && p != UnverifiedUserPolicyNoLogin {
+		return true
+	}
+	return user.IsEmailVerified()
+}
+
+// EmailVerificationはメールアドレス確認リンクのトークンに署名して含める内容
+// DBには保存せず、署名で改ざんを防ぐ。メールアドレスを含めるため、アドレスが変わると以前のリンクは使えない
+type EmailVerification struct {
+	UserID    int64
+	Email     string
+	ExpiresAt time.Time
+}
+
+// NewEmailVerificationはユーザーの現在のメールアドレスを確認するための内容を作成
+func NewEmailVerification(clock Clock, user *User, ttl time.Duration) EmailVerification {
+	return EmailVerification{
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: clock.Now().Add(ttl),
+	}
+}
+
+// Verifyはトークンが期限内で、ユーザーの現在のメールアドレスに対するものかを検証
+func (v EmailVerification) Verify(user *User, now time.Time) error {
+	if v.UserID != user.ID || v.Email != user.Email || !now.Before(v.ExpiresAt) {
+		return ErrInvalidVerificationToken
+	}
+	return nil
+}
@@ -13,23 +13,66 @@ var (
 
 // User関連
 var (
-	ErrUserNotFound     = errors.New("user not found")
-	ErrDuplicateEmail   = errors.New("email already exists")
-	ErrInvalidEmail     = errors.New("invalid email format")
-	ErrInvalidPassword  = errors.New("invalid password")
-	ErrPasswordTooShort = errors.New("password must be at least 8 characters")
-	ErrInvalidName      = errors.New("name is required")
-	ErrNameTooLong      = errors.New("name must be less than 100 characters")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrDuplicateEmail      = errors.New("email already exists")
+	ErrInvalidEmail        = errors.New("invalid email format")
+	ErrInvalidPassword     = errors.New("invalid password")
+	ErrPasswordTooShort    = errors.New("password must be at least 8 characters")
+	ErrInvalidName         = errors.New("name is required")
+	ErrNameTooLong         = errors.New("name must be less than 100 characters")
+	ErrInvalidTimezone     = errors.New("invalid timezone")
+	ErrEmailNotVerified    = errors.New("email address has not been verified")
+	ErrAssigneeNotVerified = errors.New("assignee has not verified their email address")
 )
 
 // Task関連
 var (
 	ErrTaskNotFound           = errors.New("task not found")
+	ErrTaskVersionMismatch    = errors.New("task has been modified by someone else")
+	ErrRevisionNotFound       = errors.New("task revision not found")
 	ErrTitleRequired          = errors.New("title is required")
 	ErrTitleTooLong           = errors.New("title must be less than 255 characters")
 	ErrInvalidPriority        = errors.New("priority must be between 0 and 5")
 	ErrInvalidStatus          = errors.New("invalid task status")
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrStartAfterDue          = errors.New("start date must not be after due date")
+	ErrInvalidDateRange       = errors.New("invalid date range")
+)
+
+// タスク一覧の絞り込み関連
+var (
+	ErrInvalidTaskRole = errors.New("role must be owner or assigned")
+	ErrInvalidSortKey  = errors.New("sort must be one of createdAt, dueDate, priority, updatedAt and order must be asc or desc")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+)
+
+// 保存済みビュー関連
+var (
+	ErrViewNotFound             = errors.New("view not found")
+	ErrInvalidViewName          = errors.New("view name is required")
+	ErrViewNameTooLong          = errors.New("view name must be 100 characters or less")
+	ErrDuplicateViewName        = errors.New("view with the same name already exists")
+	ErrCannotShareViewWithOwner = errors.New("view cannot be shared with its owner")
+	ErrViewShareNotFound        = errors.New("view share not found")
+)
+
+// 一括操作関連
+var (
+	ErrInvalidBulkRequest = errors.New("invalid bulk request")
+	ErrBulkAborted        = errors.New("not applied because another task in the bulk operation failed")
+)
+
+// インポート関連
+var (
+	ErrInvalidImport = errors.New("import must contain 1 to 1000 rows")
+)
+
+// TaskDependency関連
+var (
+	ErrSelfDependency      = errors.New("task cannot depend on itself")
+	ErrDependencyCycle     = errors.New("dependency would create a cycle")
+	ErrDuplicateDependency = errors.New("dependency already exists")
+	ErrDependencyNotFound  = errors.New("dependency not found")
 )
 
 // TaskAssignee関連
@@ -38,10 +81,54 @@ var (
 	ErrAssigneeNotFound  = errors.New("assignee not found")
 )
 
+// Group関連
+var (
+	ErrGroupNotFound          = errors.New("group not found")
+	ErrInvalidGroupName       = errors.New("group name is required")
+	ErrGroupNameTooLong       = errors.New("group name must be less than 100 characters")
+	ErrDuplicateGroupMember   = errors.New("user is already a member of this group")
+	ErrGroupMemberNotFound    = errors.New("group member not found")
+	ErrDuplicateGroupAssignee = errors.New("group already assigned to this task")
+)
+
+// TaskShare関連
+var (
+	ErrInvalidSharePermission = errors.New("share permission must be VIEW")
+	ErrCannotShareWithOwner   = errors.New("task cannot be shared with its owner")
+	ErrShareNotFound          = errors.New("share not found")
+)
+
+// 検索関連
+var (
+	ErrInvalidSearchQuery = errors.New("search query must be 1 to 200 characters")
+)
+
+// クイック追加関連
+var (
+	ErrUnresolvedMention = errors.New("mentioned user was not found or is ambiguous")
+)
+
+// CalendarToken関連
+var (
+	ErrCalendarTokenNotFound = errors.New("calendar token not found")
+)
+
+// 冪等キー関連
+var (
+	ErrInvalidIdempotencyKey = errors.New("idempotency key must be 1 to 255 characters without whitespace")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInUse   = errors.New("a request with the same idempotency key is being processed")
+)
+
 // 認証関連
 var (
 	ErrInvalidToken = errors.New("invalid or expired token")
 	ErrTokenExpired = errors.New("token has expired")
+	ErrRefreshTokenNotFound     = errors.New("refresh token not found")
+	ErrRefreshTokenReused       = errors.New("refresh token has already been used")
+	ErrSessionNotFound          = errors.New("session not found")
+	ErrInvalidResetToken        = errors.New("password reset token is invalid, expired or already used")
+	ErrInvalidVerificationToken = errors.New("email verification token is invalid or expired")
 )
 
 // Validation補助構造体
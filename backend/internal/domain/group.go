@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Groupはタスクをまとめてアサインできるユーザーのグループ（例: QAチーム）
+type Group struct {
+	ID        int64
+	Name      string
+	CreatedBy int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GroupMemberはグループへの所属を表す
+type GroupMember struct {
+	GroupID   int64
+	UserID    int64
+	AddedBy   int64
+	CreatedAt time.Time
+}
+
+// TaskGroupAssigneeはタスクへのグループ単位のアサインを表す
+type TaskGroupAssignee struct {
+	TaskID     int64
+	GroupID    int64
+	AssignedBy int64
+	CreatedAt  time.Time
+}
+
+// NewGroupは新しいグループを作成
+func NewGroup(clock Clock, createdBy int64, name string) (*Group, error) {
+	now := clock.Now()
+	g := &Group{
+		Name:      strings.TrimSpace(name),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := g.ValidateName(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ValidateNameはグループ名を検証
+func (g *Group) ValidateName() error {
+	if strings.TrimSpace(g.Name) == "" {
+		return ErrInvalidGroupName
+	}
+	if len(g.Name) > 100 {
+		return ErrGroupNameTooLong
+	}
+	return nil
+}
+
+// Renameはグループ名を変更
+func (g *Group) Rename(clock Clock, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidGroupName
+	}
+	if len(name) > 100 {
+		return ErrGroupNameTooLong
+	}
+	g.Name = name
+	g.UpdatedAt = clock.Now()
+	return nil
+}
+
+// IsCreatorはグループの作成者かどうかを判定
+func (g *Group) IsCreator(userID int64) bool {
+	return g.CreatedBy == userID
+}
+
+// NewGroupMemberは新しいグループメンバーを作成
+func NewGroupMember(clock Clock, groupID, userID, addedBy int64) *GroupMember {
+	return &GroupMember{
+		GroupID:   groupID,
+		UserID:    userID,
+		AddedBy:   addedBy,
+		CreatedAt: clock.Now(),
+	}
+}
+
+// NewTaskGroupAssigneeは新しいグループアサインを作成
+func NewTaskGroupAssignee(clock Clock, taskID, groupID, assignedBy int64) *TaskGroupAssignee {
+	return &TaskGroupAssignee{
+		TaskID:     taskID,
+		GroupID:    groupID,
+		AssignedBy: assignedBy,
+		CreatedAt:  clock.Now(),
+	}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// maxIdempotencyKeyLengthは冪等キーの最大長
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyRecordは冪等キー（Idempotency-Keyヘッダー）ごとのリクエストの処理結果
+// 処理中はStatusCodeが0で、処理が終わるとレスポンスを保存してリトライ時に再送する
+type IdempotencyRecord struct {
+	// UserIDはキーの名前空間（認証不要のエンドポイントでは0）
+	UserID      int64
+	Key         string
+	Fingerprint string
+
+	StatusCode      int
+	ResponseHeaders map[string]string
+	ResponseBody    []byte
+	// ResourceIDはレスポンスを保存しないエンドポイント（トークンを返すユーザー登録）で作成したリソースのID
+	// リトライ時は保存したレスポンスの代わりに、このIDからレスポンスを作り直す
+	ResourceID *int64
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// NewIdempotencyRecordは処理中の冪等キーの記録を作成
+func NewIdempotencyRecord(clock Clock, userID int64, key, fingerprint string, ttl time.Duration) (*IdempotencyRecord, error) {
+	if err := ValidateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+	now := clock.Now()
+	return &IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+// ValidateIdempotencyKeyは冪等キーの形式を検証（空白を含まない1〜255文字）
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || len(key) > maxIdempotencyKeyLength || strings.ContainsAny(key, " \t\r\n") {
+		return ErrInvalidIdempotencyKey
+	}
+	return nil
+}
+
+// IdempotencyFingerprintはリクエストのメソッド・パス・ボディから同一のリクエストかを判定するためのハッシュを作成
+func IdempotencyFingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// IsExpiredは記録の有効期限が切れているかを判定
+func (r *IdempotencyRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// IsCompletedはレスポンスが保存済み（処理が終わっている）かを判定
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.StatusCode != 0
+}
+
+// Matchは同じキーのリトライが最初のリクエストと同じ内容かを検証する
+// 内容が異なる場合はErrIdempotencyKeyReused、最初のリクエストが処理中の場合はErrIdempotencyKeyInUseを返す
+func (r *IdempotencyRecord) Match(fingerprint string) error {
+	if r.Fingerprint != fingerprint {
+		return ErrIdempotencyKeyReused
+	}
+	if !r.IsCompleted() {
+		return ErrIdempotencyKeyInUse
+	}
+	return nil
+}
+
+// Completeは処理結果のレスポンスを記録する
+func (r *IdempotencyRecord) Complete(statusCode int, headers map[string]string, body []byte) {
+	r.StatusCode = statusCode
+	r.ResponseHeaders = headers
+	r.ResponseBody = body
+}
+
+// CompleteWithResourceはレスポンスを保存せず、処理で作成したリソースのIDのみを記録する
+// トークンなどの秘密情報を含むレスポンスを保存しないために使う
+func (r *IdempotencyRecord) CompleteWithResource(statusCode int, resourceID int64) {
+	r.StatusCode = statusCode
+	r.ResourceID = &resourceID
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// Mailはユーザーに送るメール（本文はプレーンテキスト）
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MailSenderはメールを送信する
+type MailSender interface {
+	Send(ctx context.Context, mail Mail) error
+}
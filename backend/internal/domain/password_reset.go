@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+// PasswordResetTokenはメールで送るパスワード再設定用の1回限りのトークン
+// トークンそのものはメールでのみ送り、DBにはハッシュのみ保存する
+type PasswordResetToken struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	// UsedAtはパスワードを再設定した日時（使用済みのトークンは再び使えない）
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewPasswordResetTokenは新しいトークンを生成し、エンティティと平文のトークンを返す
+func NewPasswordResetToken(clock Clock, userID int64, ttl time.Duration) (*PasswordResetToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	now := clock.Now()
+	return &PasswordResetToken{
+		UserID:    userID,
+		TokenHash: HashPasswordResetToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// HashPasswordResetTokenはトークンのSHA-256ハッシュ（16進数）を返す
+func HashPasswordResetToken(token string) string {
+	return hashToken(token)
+}
+
+// IsUsableは未使用かつ期限内かを判定
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -1,7 +1,8 @@
 package domain
 
 // ユーザーがタスクを閲覧できるかチェックする
-func CanViewTask(task *Task, assignees []*TaskAssignee, userID int64) bool {
+// userGroupIDsはユーザーが所属するグループIDの一覧
+func CanViewTask(task *Task, assignees []*TaskAssignee, groupAssignees []*TaskGroupAssignee, userGroupIDs []int64, shares []*TaskShare, userID int64) bool {
 	// オーナーなら閲覧可能
 	if task.OwnerID == userID {
 		return true
@@ -13,7 +14,23 @@ func CanViewTask(task *Task, assignees []*TaskAssignee, userID int64) bool {
 			return true
 		}
 	}
-	// オーナーでもアサイン先でもない
+
+	// 所属グループがアサインされているかチェック
+	for _, groupAssignee := range groupAssignees {
+		for _, groupID := range userGroupIDs {
+			if groupAssignee.GroupID == groupID {
+				return true
+			}
+		}
+	}
+
+	// 共有されているかチェック（閲覧のみでアサインにはならない）
+	for _, share := range shares {
+		if share.UserID == userID {
+			return true
+		}
+	}
+	// オーナーでもアサイン先でも共有先でもない
 	return false
 }
 
@@ -31,3 +48,13 @@ func CanDeleteTask(task *Task, userID int64) bool {
 func CanManageAssignees(task *Task, userID int64) bool {
 	return task.OwnerID == userID
 }
+
+// ユーザーがタスクの共有設定を管理できるかチェックする
+func CanManageShares(task *Task, userID int64) bool {
+	return task.OwnerID == userID
+}
+
+// ユーザーがグループを編集・削除できるかチェックする
+func CanManageGroup(group *Group, userID int64) bool {
+	return group.CreatedBy == userID
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+// RefreshTokenはアクセストークンの再発行に使うリフレッシュトークン
+// トークンそのものは発行時にのみ返し、DBにはハッシュのみ保存する
+// 再発行のたびに同じセッションの新しいトークンに置き換える（ローテーション）
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	SessionID int64
+	TokenHash string
+	ExpiresAt time.Time
+	// RotatedAtは新しいトークンに置き換えた日時（置き換え済みのトークンが再び使われた場合は漏洩とみなす）
+	RotatedAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewRefreshTokenはセッションの新しいトークンを生成し、エンティティと平文のトークンを返す
+func NewRefreshToken(clock Clock, session *Session) (*RefreshToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return &RefreshToken{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		TokenHash: HashRefreshToken(token),
+		ExpiresAt: session.ExpiresAt,
+		CreatedAt: clock.Now(),
+	}, token, nil
+}
+
+// HashRefreshTokenはトークンのSHA-256ハッシュ（16進数）を返す
+func HashRefreshToken(token string) string {
+	return hashToken(token)
+}
+
+// IsRotatedは新しいトークンに置き換え済みかを判定
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// IsExpiredは有効期限が切れているかを判定
+// 無効化はセッション単位で行うため、セッションが有効かどうかは別に判定する
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -19,14 +19,142 @@ type UserRepository interface {
 type TaskRepository interface {
 	Create(ctx context.Context, ex Executor, task *Task) error
 	FindByID(ctx context.Context, ex Executor, taskID int64) (*Task, error)
-	ListByUserID(ctx context.Context, ex Executor, userID int64, limit, offset int) ([]*Task, error)
+	// FindByIDForUpdateはトランザクションが終わるまで他の更新を待たせるよう、行をロックして取得する
+	FindByIDForUpdate(ctx context.Context, ex Executor, taskID int64) (*Task, error)
+	ListByUserID(ctx context.Context, ex Executor, userID int64, filter TaskFilter, after *TaskCursor, limit int) ([]*Task, error)
+	CountByUserID(ctx context.Context, ex Executor, userID int64, filter TaskFilter) (int, error)
+	ListScheduledByUserID(ctx context.Context, ex Executor, userID int64, r ScheduleRange) ([]*Task, error)
+	ListUnescalatedOpen(ctx context.Context, ex Executor, priorities []int) ([]*Task, error)
+	StatsByUserID(ctx context.Context, ex Executor, userID int64, bounds TaskStatsBounds) (*TaskStats, error)
+	FindVisibleIDs(ctx context.Context, ex Executor, userID int64, taskIDs []int64) ([]int64, error)
 	Update(ctx context.Context, ex Executor, task *Task) error
-	Delete(ctx context.Context, ex Executor, taskID int64, now time.Time) error
+	Delete(ctx context.Context, ex Executor, taskID, version int64, now time.Time) error
+}
+
+// TaskRevisionRepositoryはタスクのリビジョン（変更履歴のスナップショット）の永続化操作を定義
+type TaskRevisionRepository interface {
+	Create(ctx context.Context, ex Executor, revision *TaskRevision) error
+	FindByTaskID(ctx context.Context, ex Executor, taskID int64) ([]*TaskRevision, error)
+	FindByRevision(ctx context.Context, ex Executor, taskID, revision int64) (*TaskRevision, error)
+}
+
+// TaskStatusTransitionRepositoryはタスクのステータス遷移の記録の永続化操作を定義
+type TaskStatusTransitionRepository interface {
+	Create(ctx context.Context, ex Executor, transition *TaskStatusTransition) error
+	FindVisibleByUserID(ctx context.Context, ex Executor, userID int64, until time.Time) ([]*TaskStatusTransition, error)
 }
 
 // TaskAssigneeRepositoryはタスク担当者の永続化操作を定義
 type TaskAssigneeRepository interface {
 	Create(ctx context.Context, ex Executor, assignee *TaskAssignee) error
+	Delete(ctx context.Context, ex Executor, taskID, userID int64) error
 	FindByTaskID(ctx context.Context, ex Executor, taskID int64) ([]*TaskAssignee, error)
 	DeleteByTaskID(ctx context.Context, ex Executor, taskID int64) error
+	WorkloadByUserID(ctx context.Context, ex Executor, viewerID int64, filter TaskFilter) ([]*AssigneeWorkload, error)
+}
+
+// GroupRepositoryはグループの永続化操作を定義
+type GroupRepository interface {
+	Create(ctx context.Context, ex Executor, group *Group) error
+	FindByID(ctx context.Context, ex Executor, groupID int64) (*Group, error)
+	FindAll(ctx context.Context, ex Executor) ([]*Group, error)
+	Update(ctx context.Context, ex Executor, group *Group) error
+	Delete(ctx context.Context, ex Executor, groupID int64) error
+}
+
+// GroupMemberRepositoryはグループメンバーの永続化操作を定義
+type GroupMemberRepository interface {
+	Create(ctx context.Context, ex Executor, member *GroupMember) error
+	Delete(ctx context.Context, ex Executor, groupID, userID int64) error
+	FindByGroupID(ctx context.Context, ex Executor, groupID int64) ([]*GroupMember, error)
+	FindGroupIDsByUserID(ctx context.Context, ex Executor, userID int64) ([]int64, error)
+}
+
+// TaskGroupAssigneeRepositoryはタスクのグループアサインの永続化操作を定義
+type TaskGroupAssigneeRepository interface {
+	Create(ctx context.Context, ex Executor, assignee *TaskGroupAssignee) error
+	FindByTaskID(ctx context.Context, ex Executor, taskID int64) ([]*TaskGroupAssignee, error)
+	DeleteByTaskID(ctx context.Context, ex Executor, taskID int64) error
+}
+
+// TaskShareRepositoryはタスク共有設定の永続化操作を定義
+type TaskShareRepository interface {
+	Upsert(ctx context.Context, ex Executor, share *TaskShare) error
+	Delete(ctx context.Context, ex Executor, taskID, userID int64) error
+	FindByTaskID(ctx context.Context, ex Executor, taskID int64) ([]*TaskShare, error)
+	DeleteByTaskID(ctx context.Context, ex Executor, taskID int64) error
+}
+
+// SavedViewRepositoryは保存済みビューの永続化操作を定義
+type SavedViewRepository interface {
+	Create(ctx context.Context, ex Executor, view *SavedView) error
+	FindByID(ctx context.Context, ex Executor, viewID int64) (*SavedView, error)
+	ListAccessibleByUserID(ctx context.Context, ex Executor, userID int64) ([]*SavedView, error)
+	Update(ctx context.Context, ex Executor, view *SavedView) error
+	Delete(ctx context.Context, ex Executor, viewID int64) error
+}
+
+// SavedViewShareRepositoryは保存済みビューの共有の永続化操作を定義
+type SavedViewShareRepository interface {
+	Upsert(ctx context.Context, ex Executor, share *SavedViewShare) error
+	Delete(ctx context.Context, ex Executor, viewID, userID int64) error
+	FindByViewID(ctx context.Context, ex Executor, viewID int64) ([]*SavedViewShare, error)
+}
+
+// TaskDependencyRepositoryはタスク間の依存関係の永続化操作を定義
+type TaskDependencyRepository interface {
+	Create(ctx context.Context, ex Executor, dependency *TaskDependency) error
+	Delete(ctx context.Context, ex Executor, taskID, dependsOnTaskID int64) error
+	FindByTaskID(ctx context.Context, ex Executor, taskID int64) ([]*TaskDependency, error)
+	FindByTaskIDs(ctx context.Context, ex Executor, taskIDs []int64) ([]*TaskDependency, error)
+	DeleteByTaskID(ctx context.Context, ex Executor, taskID int64) error
+}
+
+// SLAPolicyRepositoryはSLAポリシーの永続化操作を定義
+type SLAPolicyRepository interface {
+	FindAll(ctx context.Context, ex Executor) ([]*SLAPolicy, error)
+}
+
+// CalendarTokenRepositoryはカレンダーフィード用トークンの永続化操作を定義
+type CalendarTokenRepository interface {
+	Upsert(ctx context.Context, ex Executor, token *CalendarToken) error
+	FindByTokenHash(ctx context.Context, ex Executor, tokenHash string) (*CalendarToken, error)
+	DeleteByUserID(ctx context.Context, ex Executor, userID int64) error
+}
+
+// RefreshTokenRepositoryはリフレッシュトークンの永続化操作を定義
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, ex Executor, token *RefreshToken) error
+	FindByTokenHash(ctx context.Context, ex Executor, tokenHash string) (*RefreshToken, error)
+	MarkRotated(ctx context.Context, ex Executor, tokenID int64, now time.Time) error
+	DeleteExpired(ctx context.Context, ex Executor, now time.Time) (int64, error)
+}
+
+// SessionRepositoryはログインセッションの永続化操作を定義
+type SessionRepository interface {
+	Create(ctx context.Context, ex Executor, session *Session) error
+	FindByID(ctx context.Context, ex Executor, sessionID int64) (*Session, error)
+	FindActiveByUserID(ctx context.Context, ex Executor, userID int64, now time.Time) ([]*Session, error)
+	Update(ctx context.Context, ex Executor, session *Session) error
+	Revoke(ctx context.Context, ex Executor, sessionID int64, now time.Time) error
+	RevokeByUserID(ctx context.Context, ex Executor, userID, exceptSessionID int64, now time.Time) (int64, error)
+	DeleteExpired(ctx context.Context, ex Executor, now time.Time) (int64, error)
+}
+
+// PasswordResetTokenRepositoryはパスワード再設定トークンの永続化操作を定義
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, ex Executor, token *PasswordResetToken) error
+	FindByTokenHash(ctx context.Context, ex Executor, tokenHash string) (*PasswordResetToken, error)
+	MarkUsed(ctx context.Context, ex Executor, tokenID int64, now time.Time) error
+	DeleteUnusedByUserID(ctx context.Context, ex Executor, userID int64) error
+	DeleteExpired(ctx context.Context, ex Executor, now time.Time) (int64, error)
+}
+
+// IdempotencyRepositoryは冪等キーの記録の永続化操作を定義
+type IdempotencyRepository interface {
+	Create(ctx context.Context, ex Executor, record *IdempotencyRecord) error
+	FindByKey(ctx context.Context, ex Executor, userID int64, key string) (*IdempotencyRecord, error)
+	Complete(ctx context.Context, ex Executor, record *IdempotencyRecord) error
+	Delete(ctx context.Context, ex Executor, userID int64, key string) error
+	DeleteExpired(ctx context.Context, ex Executor, now time.Time) (int64, error)
 }
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// maxViewNameLengthは保存済みビューの名前の最大文字数
+const maxViewNameLength = 100
+
+// SavedViewはユーザーが名前を付けて保存したタスク一覧の絞り込み条件と並び順
+// 実行時の閲覧範囲は常に実行したユーザーのものになる（共有されても閲覧範囲は広がらない）
+type SavedView struct {
+	ID        int64
+	OwnerID   int64
+	Name      string
+	Filter    TaskFilter
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SavedViewShareは保存済みビューの他のユーザーへの共有を表す
+type SavedViewShare struct {
+	ViewID    int64
+	UserID    int64
+	SharedBy  int64
+	CreatedAt time.Time
+}
+
+// NewSavedViewは新しい保存済みビューを作成
+func NewSavedView(clock Clock, ownerID int64, name string, filter TaskFilter) (*SavedView, error) {
+	now := clock.Now()
+	v := &SavedView{
+		OwnerID:   ownerID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := v.Rename(clock, name); err != nil {
+		return nil, err
+	}
+	if err := v.UpdateFilter(clock, filter); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Renameはビューの名前を変更
+func (v *SavedView) Rename(clock Clock, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidViewName
+	}
+	if len([]rune(name)) > maxViewNameLength {
+		return ErrViewNameTooLong
+	}
+	v.Name = name
+	v.UpdatedAt = clock.Now()
+	return nil
+}
+
+// UpdateFilterはビューの絞り込み条件を変更
+// 期限切れの判定基準（NowとLocation）は実行時に決まるため保存しない
+func (v *SavedView) UpdateFilter(clock Clock, filter TaskFilter) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	filter.Now = time.Time{}
+	filter.Location = nil
+	v.Filter = filter
+	v.UpdatedAt = clock.Now()
+	return nil
+}
+
+// IsOwnerはビューのオーナーかどうかを判定
+func (v *SavedView) IsOwner(userID int64) bool {
+	return v.OwnerID == userID
+}
+
+// NewSavedViewShareは新しいビューの共有を作成
+func NewSavedViewShare(clock Clock, view *SavedView, userID, sharedBy int64) (*SavedViewShare, error) {
+	if view.IsOwner(userID) {
+		return nil, ErrCannotShareViewWithOwner
+	}
+	return &SavedViewShare{
+		ViewID:    view.ID,
+		UserID:    userID,
+		SharedBy:  sharedBy,
+		CreatedAt: clock.Now(),
+	}, nil
+}
+
+// CanUseSavedViewはユーザーがビューを参照・実行できるかを判定（オーナーまたは共有先）
+func CanUseSavedView(view *SavedView, shares []*SavedViewShare, userID int64) bool {
+	if view.IsOwner(userID) {
+		return true
+	}
+	for _, share := range shares {
+		if share.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "context"
+
+// TaskSearchHitは全文検索で一致したタスクと関連度のスコア
+type TaskSearchHit struct {
+	TaskID int64
+	Score  float64
+}
+
+// TaskSearchIndexはタスクのタイトル・説明の全文検索インデックス
+type TaskSearchIndex interface {
+	// Indexはタスクを索引に登録する（登録済みの場合は置き換える）
+	Index(ctx context.Context, ex Executor, task *Task) error
+	// Removeはタスクを索引から削除する
+	Remove(ctx context.Context, ex Executor, taskID int64) error
+	// Searchはユーザーが閲覧可能なタスクのうち、クエリのすべての語を含むものをスコアの高い順に最大limit件返す
+	Search(ctx context.Context, ex Executor, userID int64, query string, limit int) ([]TaskSearchHit, error)
+}
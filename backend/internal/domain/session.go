@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// maxUserAgentLengthは保存するUser-Agentの最大文字数（sessions.user_agentの長さ）
+const maxUserAgentLength = 512
+
+// Sessionはログインごとのセッション（端末ごとのログイン状態）
+// アクセストークンはsidクレームでセッションを参照し、セッションを無効にするとその端末だけがログアウトされる
+type Session struct {
+	ID        int64
+	UserID    int64
+	UserAgent string
+	IPAddress string
+	CreatedAt time.Time
+	// LastUsedAtは最後にトークンを発行・再発行した日時
+	LastUsedAt time.Time
+	// ExpiresAtはリフレッシュトークンの有効期限（再発行のたびに延長される）
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+// NewSessionは新しいセッションを作成する
+func NewSession(clock Clock, userID int64, userAgent, ipAddress string, ttl time.Duration) *Session {
+	now := clock.Now()
+	return &Session{
+		UserID:     userID,
+		UserAgent:  truncateUserAgent(userAgent),
+		IPAddress:  ipAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+}
+
+// Touchはトークンの再発行時に最終利用日時・接続元・有効期限を更新する
+func (s *Session) Touch(clock Clock, userAgent, ipAddress string, ttl time.Duration) {
+	now := clock.Now()
+	s.LastUsedAt = now
+	s.ExpiresAt = now.Add(ttl)
+	if userAgent != "" {
+		s.UserAgent = truncateUserAgent(userAgent)
+	}
+	if ipAddress != "" {
+		s.IPAddress = ipAddress
+	}
+}
+
+// IsActiveは期限内かつ無効化されていないかを判定
+func (s *Session) IsActive(now time.Time) bool {
+	return !s.IsRevoked() && now.Before(s.ExpiresAt)
+}
+
+// IsRevokedはログアウトなどで無効にされたかを判定
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// truncateUserAgentはUser-Agentを保存できる長さに切り詰める
+func truncateUserAgent(userAgent string) string {
+	if utf8.RuneCountInString(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+	return string([]rune(userAgent)[:maxUserAgentLength])
+}
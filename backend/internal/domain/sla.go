@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SLAEscalationActionはSLA違反が近づいたときのエスカレーション方法
+type SLAEscalationAction string
+
+const (
+	SLAEscalationRaisePriority SLAEscalationAction = "RAISE_PRIORITY"
+	SLAEscalationNotifyOwner   SLAEscalationAction = "NOTIFY_OWNER"
+	SLAEscalationBoth          SLAEscalationAction = "BOTH"
+)
+
+// maxPriorityはタスクの優先度の上限
+const maxPriority = 5
+
+// SLAPolicyは優先度ごとのSLA（着手・完了までの期限）
+type SLAPolicy struct {
+	Priority         int
+	StartWithin      time.Duration
+	ResolveWithin    time.Duration
+	EscalateBefore   time.Duration
+	EscalationAction SLAEscalationAction
+}
+
+// SLAStatusはタスクのSLA達成状況
+type SLAStatus struct {
+	StartDeadline   time.Time
+	ResolveDeadline time.Time
+	TimeToStart     *time.Duration
+	TimeToDone      *time.Duration
+	StartBreached   bool
+	ResolveBreached bool
+	NearBreach      bool
+}
+
+// BreachedはいずれかのSLAに違反しているかを判定
+func (s SLAStatus) Breached() bool {
+	return s.StartBreached || s.ResolveBreached
+}
+
+// RaisesPriorityはエスカレーション時に優先度を上げるかを判定
+func (a SLAEscalationAction) RaisesPriority() bool {
+	return a == SLAEscalationRaisePriority || a == SLAEscalationBoth
+}
+
+// NotifiesOwnerはエスカレーション時にオーナーへ通知するかを判定
+func (a SLAEscalationAction) NotifiesOwner() bool {
+	return a == SLAEscalationNotifyOwner || a == SLAEscalationBoth
+}
+
+// Evaluateはタスクの作成日時とステータス変更日時からSLA達成状況を評価
+// 着手までの時間はStartedAt、完了までの時間はCompletedAtで計測する
+func (p *SLAPolicy) Evaluate(task *Task, now time.Time) SLAStatus {
+	status := SLAStatus{
+		StartDeadline:   task.CreatedAt.Add(p.StartWithin),
+		ResolveDeadline: task.CreatedAt.Add(p.ResolveWithin),
+	}
+
+	if task.StartedAt != nil {
+		d := task.StartedAt.Sub(task.CreatedAt)
+		status.TimeToStart = &d
+		status.StartBreached = task.StartedAt.After(status.StartDeadline)
+	} else {
+		status.StartBreached = now.After(status.StartDeadline)
+		status.NearBreach = !status.StartBreached && !now.Before(status.StartDeadline.Add(-p.EscalateBefore))
+	}
+
+	if task.CompletedAt != nil {
+		d := task.CompletedAt.Sub(task.CreatedAt)
+		status.TimeToDone = &d
+		status.ResolveBreached = task.CompletedAt.After(status.ResolveDeadline)
+	} else {
+		status.ResolveBreached = now.After(status.ResolveDeadline)
+		if !status.ResolveBreached && !now.Before(status.ResolveDeadline.Add(-p.EscalateBefore)) {
+			status.NearBreach = true
+		}
+	}
+
+	return status
+}
+
+// NeedsEscalationはタスクをエスカレーションすべきかを判定
+// 未完了で、違反が近いか既に違反しており、まだエスカレーションしていないタスクが対象
+func (p *SLAPolicy) NeedsEscalation(task *Task, now time.Time) bool {
+	if task.Status == TaskStatusDONE || task.SLAEscalatedAt != nil {
+		return false
+	}
+	status := p.Evaluate(task, now)
+	return status.NearBreach || status.Breached()
+}
+
+// Notifierはユーザーへの通知を送る
+type Notifier interface {
+	NotifySLAEscalation(ctx context.Context, owner *User, task *Task, status SLAStatus) error
+}
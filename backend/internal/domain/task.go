@@ -18,9 +18,16 @@ type Task struct {
 	OwnerID int64
 	Title string
 	Description *string
+	StartDate *time.Time
 	DueDate *time.Time
+	DueAllDay bool
 	Status TaskStatus
 	Priority int
+	StartedAt *time.Time
+	CompletedAt *time.Time
+	SLAEscalatedAt *time.Time
+	// Versionは楽観的排他制御用のバージョン（作成時は1で、更新・削除のたびに1ずつ増える）
+	Version int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -33,6 +40,7 @@ func NewTask(clock Clock , ownerID int64 , title string) (*Task , error) {
 		Title: strings.TrimSpace(title),
 		Status: TaskStatusTODO,
 		Priority: 0,
+		Version: 1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -84,6 +92,42 @@ func (t *Task) ValidateStatusTransaction(nextStatus TaskStatus) error {
 	return ErrInvalidStatusTransition
 }
 
+// ChangeStatusはステータスを遷移させ、着手・完了日時を記録する（SLAの計測に用いる）
+// 着手日時は最初に着手（または直接完了）したとき、完了日時は完了したときに記録し、再オープンで完了日時をクリアする
+func (t *Task) ChangeStatus(clock Clock, nextStatus TaskStatus) error {
+	if err := t.ValidateStatusTransaction(nextStatus); err != nil {
+		return err
+	}
+	now := clock.Now()
+	switch nextStatus {
+	case TaskStatusIN_PROGRESS:
+		if t.StartedAt == nil {
+			t.StartedAt = &now
+		}
+	case TaskStatusDONE:
+		if t.StartedAt == nil {
+			t.StartedAt = &now
+		}
+		t.CompletedAt = &now
+	case TaskStatusTODO:
+		t.CompletedAt = nil
+	}
+	t.Status = nextStatus
+	t.touch(clock)
+	return nil
+}
+
+// EscalateはSLA違反が近いタスクをエスカレーションする
+// 優先度を上げる方法の場合は上限（5）まで1段階上げる
+func (t *Task) Escalate(clock Clock, action SLAEscalationAction) {
+	if action.RaisesPriority() && t.Priority < maxPriority {
+		t.Priority++
+	}
+	now := clock.Now()
+	t.SLAEscalatedAt = &now
+	t.touch(clock)
+}
+
 func isValidStatus(status TaskStatus) bool {
 	return status == TaskStatusTODO || status == TaskStatusIN_PROGRESS || status == TaskStatusDONE
 }
@@ -124,12 +168,80 @@ func (t *Task) UpdateDescription (clock Clock , description *string) {
 	t.touch(clock)
 }
 
-// 期日の更新
+// 期日の更新（時刻まで指定した締め切り。UTCで保持する）
 func (t *Task) UpdateDueDate (clock Clock , dueDate *time.Time) {
+	if dueDate != nil {
+		utc := dueDate.UTC()
+		dueDate = &utc
+	}
 	t.DueDate = dueDate
+	t.DueAllDay = false
+	t.touch(clock)
+}
+
+// 終日の期日の更新（日付のみ。UTCの0時として保持する）
+func (t *Task) UpdateAllDayDueDate (clock Clock , date *time.Time) {
+	if date == nil {
+		t.DueDate = nil
+		t.DueAllDay = false
+		t.touch(clock)
+		return
+	}
+	y, m, d := date.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	t.DueDate = &day
+	t.DueAllDay = true
 	t.touch(clock)
 }
 
+// DueDeadlineは期日の締め切り時刻を返す
+// 終日の期日はlocのタイムゾーンでその日の終わり（翌日0時）が締め切りとなる
+func (t *Task) DueDeadline(loc *time.Location) *time.Time {
+	if t.DueDate == nil {
+		return nil
+	}
+	if !t.DueAllDay {
+		return t.DueDate
+	}
+	y, m, d := t.DueDate.Date()
+	deadline := time.Date(y, m, d, 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+	return &deadline
+}
+
+// IsOverdueは未完了のタスクが締め切りを過ぎているかを判定
+func (t *Task) IsOverdue(now time.Time, loc *time.Location) bool {
+	if t.Status == TaskStatusDONE {
+		return false
+	}
+	deadline := t.DueDeadline(loc)
+	if deadline == nil {
+		return false
+	}
+	return !now.Before(*deadline)
+}
+
+// 開始日の更新（UTCで保持する）
+func (t *Task) UpdateStartDate (clock Clock , startDate *time.Time) {
+	if startDate != nil {
+		utc := startDate.UTC()
+		startDate = &utc
+	}
+	t.StartDate = startDate
+	t.touch(clock)
+}
+
+// ValidateScheduleは開始日が期日より後になっていないかを検証
+// 終日の期日はその日の終わり（UTC）までを期日とみなす
+func (t *Task) ValidateSchedule() error {
+	if t.StartDate == nil || t.DueDate == nil {
+		return nil
+	}
+	if t.StartDate.After(*t.DueDeadline(time.UTC)) {
+		return ErrStartAfterDue
+	}
+	return nil
+}
+
 // 優先度の更新
 func (t *Task) UpdatePriority (clock Clock , priority int) error {
 	t.Priority = priority
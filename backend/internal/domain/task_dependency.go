@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// TaskDependencyはタスク間の依存関係（TaskIDのタスクはDependsOnTaskIDのタスクの完了を待つ）
+type TaskDependency struct {
+	TaskID          int64
+	DependsOnTaskID int64
+	CreatedBy       int64
+	CreatedAt       time.Time
+}
+
+// NewTaskDependencyは新しい依存関係を作成
+func NewTaskDependency(clock Clock, taskID, dependsOnTaskID, createdBy int64) (*TaskDependency, error) {
+	if taskID == dependsOnTaskID {
+		return nil, ErrSelfDependency
+	}
+	return &TaskDependency{
+		TaskID:          taskID,
+		DependsOnTaskID: dependsOnTaskID,
+		CreatedBy:       createdBy,
+		CreatedAt:       clock.Now(),
+	}, nil
+}
+
+// WouldCreateDependencyCycleはtaskID→dependsOnTaskIDの依存を追加すると循環するかを判定
+// existingにはdependsOnTaskIDから辿れる既存の依存関係を渡す
+func WouldCreateDependencyCycle(existing []*TaskDependency, taskID, dependsOnTaskID int64) bool {
+	if taskID == dependsOnTaskID {
+		return true
+	}
+
+	graph := make(map[int64][]int64, len(existing))
+	for _, dep := range existing {
+		graph[dep.TaskID] = append(graph[dep.TaskID], dep.DependsOnTaskID)
+	}
+
+	// dependsOnTaskIDから依存を辿ってtaskIDに到達すれば循環する
+	visited := map[int64]bool{dependsOnTaskID: true}
+	queue := []int64{dependsOnTaskID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range graph[current] {
+			if next == taskID {
+				return true
+			}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
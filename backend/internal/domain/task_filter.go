@@ -0,0 +1,156 @@
+package domain
+
+import "time"
+
+// TaskRoleはタスク一覧でのユーザーの関わり方による絞り込み
+type TaskRole string
+
+const (
+	// TaskRoleAnyは閲覧可能なすべてのタスク
+	TaskRoleAny TaskRole = ""
+	// TaskRoleOwnerは自分がオーナーのタスク
+	TaskRoleOwner TaskRole = "OWNER"
+	// TaskRoleAssignedは自分が直接またはグループとしてアサインされているタスク
+	TaskRoleAssigned TaskRole = "ASSIGNED"
+)
+
+// TaskSortKeyはタスク一覧の並び替えに使える項目
+type TaskSortKey string
+
+const (
+	TaskSortCreatedAt TaskSortKey = "CREATED_AT"
+	TaskSortDueDate   TaskSortKey = "DUE_DATE"
+	TaskSortPriority  TaskSortKey = "PRIORITY"
+	TaskSortUpdatedAt TaskSortKey = "UPDATED_AT"
+)
+
+// SortDirectionは並び順
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "ASC"
+	SortDesc SortDirection = "DESC"
+)
+
+// TaskFilterはタスク一覧の絞り込み条件と並び順
+// 日時の範囲はいずれも[From, To)で、nilの条件は絞り込まない
+type TaskFilter struct {
+	Statuses    []TaskStatus
+	PriorityMin *int
+	PriorityMax *int
+	DueFrom     *time.Time
+	DueTo       *time.Time
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	// Overdueは期限切れかどうか（判定はTask.IsOverdueと同じで、NowとLocationを基準にする）
+	Overdue    *bool
+	Now        time.Time
+	Location   *time.Location
+	Role       TaskRole
+	AssigneeID *int64
+
+	SortKey       TaskSortKey
+	SortDirection SortDirection
+}
+
+// Validateは絞り込み条件を検証し、並び順が未指定の場合は作成日時の降順にする
+func (f *TaskFilter) Validate() error {
+	for _, status := range f.Statuses {
+		if !isValidStatus(status) {
+			return ErrInvalidStatus
+		}
+	}
+	for _, priority := range []*int{f.PriorityMin, f.PriorityMax} {
+		if priority != nil && (*priority < 0 || *priority > maxPriority) {
+			return ErrInvalidPriority
+		}
+	}
+	if f.PriorityMin != nil && f.PriorityMax != nil && *f.PriorityMin > *f.PriorityMax {
+		return ErrInvalidPriority
+	}
+	for _, r := range [][2]*time.Time{{f.DueFrom, f.DueTo}, {f.CreatedFrom, f.CreatedTo}, {f.UpdatedFrom, f.UpdatedTo}} {
+		if r[0] != nil && r[1] != nil && !r[0].Before(*r[1]) {
+			return ErrInvalidDateRange
+		}
+	}
+
+	switch f.Role {
+	case TaskRoleAny, TaskRoleOwner, TaskRoleAssigned:
+	default:
+		return ErrInvalidTaskRole
+	}
+
+	switch f.SortKey {
+	case "":
+		f.SortKey = TaskSortCreatedAt
+	case TaskSortCreatedAt, TaskSortDueDate, TaskSortPriority, TaskSortUpdatedAt:
+	default:
+		return ErrInvalidSortKey
+	}
+	switch f.SortDirection {
+	case "":
+		f.SortDirection = SortDesc
+	case SortAsc, SortDesc:
+	default:
+		return ErrInvalidSortKey
+	}
+
+	if f.Location == nil {
+		f.Location = time.UTC
+	}
+
+	return nil
+}
+
+// OverdueBoundsは期限切れの判定基準を返す
+// 時刻付きの期日はnow以前、終日の期日（UTCの0時で保存）はtoday（Locationでの今日の日付をUTCの0時にしたもの）より前なら期限切れ
+func (f *TaskFilter) OverdueBounds() (now, today time.Time) {
+	local := f.Now.In(f.Location)
+	y, m, d := local.Date()
+	return f.Now.UTC(), time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// TaskCursorはタスク一覧のキーセットページングの位置（前のページの最後のタスクの並び替え値とID）
+// 並び替え項目に応じてTime（作成日時・更新日時・期日。期日なしはnil）かPriorityのどちらかを使う
+type TaskCursor struct {
+	SortKey       TaskSortKey
+	SortDirection SortDirection
+	Time          *time.Time
+	Priority      int
+	ID            int64
+}
+
+// NewTaskCursorはlastの次から取得するためのカーソルを作成
+func NewTaskCursor(filter TaskFilter, last *Task) *TaskCursor {
+	c := &TaskCursor{
+		SortKey:       filter.SortKey,
+		SortDirection: filter.SortDirection,
+		ID:            last.ID,
+	}
+	switch filter.SortKey {
+	case TaskSortDueDate:
+		c.Time = last.DueDate
+	case TaskSortPriority:
+		c.Priority = last.Priority
+	case TaskSortUpdatedAt:
+		updatedAt := last.UpdatedAt
+		c.Time = &updatedAt
+	default:
+		createdAt := last.CreatedAt
+		c.Time = &createdAt
+	}
+	return c
+}
+
+// Validateはカーソルが絞り込み条件と同じ並び順で作成されたものかを検証
+func (c *TaskCursor) Validate(filter TaskFilter) error {
+	if c.SortKey != filter.SortKey || c.SortDirection != filter.SortDirection || c.ID <= 0 {
+		return ErrInvalidCursor
+	}
+	if c.SortKey != TaskSortPriority && c.SortKey != TaskSortDueDate && c.Time == nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// TaskRevisionはタスクのある時点の内容（アサインを含む）のスナップショット
+// Revisionはその時点のタスクのVersionと同じで、タスクが作成・更新されるたびに記録する
+type TaskRevision struct {
+	TaskID           int64
+	Revision         int64
+	Title            string
+	Description      *string
+	StartDate        *time.Time
+	DueDate          *time.Time
+	DueAllDay        bool
+	Status           TaskStatus
+	Priority         int
+	AssigneeIDs      []int64
+	AssigneeGroupIDs []int64
+	// ChangedByはこのリビジョンを作成したユーザー（SLAエスカレーションなどシステムによる変更はnil）
+	ChangedBy *int64
+	CreatedAt time.Time
+}
+
+// NewTaskRevisionは保存したタスクの現在の内容からリビジョンを作成
+func NewTaskRevision(clock Clock, task *Task, assignees []*TaskAssignee, groupAssignees []*TaskGroupAssignee, changedBy *int64) *TaskRevision {
+	assigneeIDs := make([]int64, len(assignees))
+	for i, assignee := range assignees {
+		assigneeIDs[i] = assignee.UserID
+	}
+	groupIDs := make([]int64, len(groupAssignees))
+	for i, groupAssignee := range groupAssignees {
+		groupIDs[i] = groupAssignee.GroupID
+	}
+	slices.Sort(assigneeIDs)
+	slices.Sort(groupIDs)
+
+	return &TaskRevision{
+		TaskID:           task.ID,
+		Revision:         task.Version,
+		Title:            task.Title,
+		Description:      task.Description,
+		StartDate:        task.StartDate,
+		DueDate:          task.DueDate,
+		DueAllDay:        task.DueAllDay,
+		Status:           task.Status,
+		Priority:         task.Priority,
+		AssigneeIDs:      assigneeIDs,
+		AssigneeGroupIDs: groupIDs,
+		ChangedBy:        changedBy,
+		CreatedAt:        clock.Now(),
+	}
+}
+
+// TaskFieldはリビジョン間で比較するタスクの項目
+type TaskField string
+
+const (
+	TaskFieldTitle            TaskField = "title"
+	TaskFieldDescription      TaskField = "description"
+	TaskFieldStartDate        TaskField = "startDate"
+	TaskFieldDueDate          TaskField = "dueDate"
+	TaskFieldStatus           TaskField = "status"
+	TaskFieldPriority         TaskField = "priority"
+	TaskFieldAssigneeIDs      TaskField = "assigneeIds"
+	TaskFieldAssigneeGroupIDs TaskField = "assigneeGroupIds"
+)
+
+// TaskDueDateは期日と終日の期日かどうかの組（差分では期日の値として扱う）
+type TaskDueDate struct {
+	Date   *time.Time
+	AllDay bool
+}
+
+// TaskFieldChangeはリビジョン間で変わった項目の変更前後の値
+// 値の型は項目ごとにTaskRevisionのフィールドと同じ（開始日は*time.Time、アサインは[]int64）で、期日のみTaskDueDate
+type TaskFieldChange struct {
+	Field TaskField
+	From  any
+	To    any
+}
+
+// DiffTaskRevisionsはfromからtoへの項目ごとの変更を返す（変更のない項目は含まない）
+func DiffTaskRevisions(from, to *TaskRevision) []TaskFieldChange {
+	var changes []TaskFieldChange
+	add := func(field TaskField, changed bool, fromValue, toValue any) {
+		if changed {
+			changes = append(changes, TaskFieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+
+	add(TaskFieldTitle, from.Title != to.Title, from.Title, to.Title)
+	add(TaskFieldDescription, !equalStringPtr(from.Description, to.Description), from.Description, to.Description)
+	add(TaskFieldStartDate, !equalTimePtr(from.StartDate, to.StartDate), from.StartDate, to.StartDate)
+	add(TaskFieldDueDate, !equalTimePtr(from.DueDate, to.DueDate) || from.DueAllDay != to.DueAllDay,
+		TaskDueDate{Date: from.DueDate, AllDay: from.DueAllDay}, TaskDueDate{Date: to.DueDate, AllDay: to.DueAllDay})
+	add(TaskFieldStatus, from.Status != to.Status, from.Status, to.Status)
+	add(TaskFieldPriority, from.Priority != to.Priority, from.Priority, to.Priority)
+	add(TaskFieldAssigneeIDs, !slices.Equal(from.AssigneeIDs, to.AssigneeIDs), from.AssigneeIDs, to.AssigneeIDs)
+	add(TaskFieldAssigneeGroupIDs, !slices.Equal(from.AssigneeGroupIDs, to.AssigneeGroupIDs), from.AssigneeGroupIDs, to.AssigneeGroupIDs)
+
+	return changes
+}
+
+// equalStringPtrはnilを含めて文字列のポインタの値が等しいかを判定
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalTimePtrはnilを含めて時刻のポインタの値が等しいかを判定
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// ScheduleRangeは日程が重なるタスクを取得する期間[From, To)
+// 日程は開始日〜期日（片方のみの場合はその時点）とし、終日の期日はLocationのタイムゾーンでその日の0時から締め切り（Task.DueDeadline）までとみなす
+type ScheduleRange struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+}
+
+// AllDayBoundsは終日の期日（UTCの0時で保存）が期間と重なる日付の範囲[fromDay, toDay)を返す
+// fromDayは締め切りがFrom以降になる最初の日、toDayはLocationでの0時がTo以降になる最初の日
+func (r ScheduleRange) AllDayBounds() (fromDay, toDay time.Time) {
+	loc := r.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	// Fromのその日の締め切り（翌日0時）は必ずFromより後のため、前日の締め切りがFrom以降かを確認する
+	fromDay = dateOf(r.From.In(loc)).AddDate(0, 0, -1)
+	if startOfDay(fromDay, loc).AddDate(0, 0, 1).Before(r.From) {
+		fromDay = fromDay.AddDate(0, 0, 1)
+	}
+
+	// Toのその日の0時がToより前なら、翌日からが期間外となる
+	toDay = dateOf(r.To.In(loc))
+	if startOfDay(toDay, loc).Before(r.To) {
+		toDay = toDay.AddDate(0, 0, 1)
+	}
+
+	return fromDay, toDay
+}
+
+// startOfDayはUTCの0時で表した日付の、locでのその日の0時を返す
+func startOfDay(day time.Time, loc *time.Location) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
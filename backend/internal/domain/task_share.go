@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// SharePermissionは共有された閲覧者の権限レベル
+type SharePermission string
+
+// 権限は閲覧のみ（コメント機能がないため、コメントなどの権限はその機能と合わせて追加する）
+const (
+	SharePermissionView SharePermission = "VIEW"
+)
+
+// TaskShareはタスクの読み取り専用共有（アサインとは別の閲覧権限）を表す
+type TaskShare struct {
+	TaskID     int64
+	UserID     int64
+	Permission SharePermission
+	SharedBy   int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewTaskShareは新しい共有設定を作成
+func NewTaskShare(clock Clock, task *Task, userID int64, permission SharePermission, sharedBy int64) (*TaskShare, error) {
+	if task.IsOwner(userID) {
+		return nil, ErrCannotShareWithOwner
+	}
+	if !isValidSharePermission(permission) {
+		return nil, ErrInvalidSharePermission
+	}
+
+	now := clock.Now()
+	return &TaskShare{
+		TaskID:     task.ID,
+		UserID:     userID,
+		Permission: permission,
+		SharedBy:   sharedBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+func isValidSharePermission(permission SharePermission) bool {
+	return permission == SharePermissionView
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// TaskStatsはユーザーが閲覧可能なタスクの集計
+// Overdue・DueThisWeekは未完了（DONE以外）のタスクのみ数える
+// オーナーかつアサイン先のタスクはOwnedとAssignedの両方に数える
+type TaskStats struct {
+	Total       int
+	ByStatus    map[TaskStatus]int
+	ByPriority  map[int]int
+	Overdue     int
+	DueThisWeek int
+	Owned       int
+	Assigned    int
+}
+
+// NewTaskStatsはすべてのステータス・優先度の件数を0とした集計を作成
+func NewTaskStats() *TaskStats {
+	stats := &TaskStats{
+		ByStatus: map[TaskStatus]int{
+			TaskStatusTODO:        0,
+			TaskStatusIN_PROGRESS: 0,
+			TaskStatusDONE:        0,
+		},
+		ByPriority: make(map[int]int, maxPriority+1),
+	}
+	for priority := 0; priority <= maxPriority; priority++ {
+		stats.ByPriority[priority] = 0
+	}
+	return stats
+}
+
+// TaskStatsBoundsは期限切れ・今週の期日の判定基準
+// 時刻付きの期日はNow・WeekStart・WeekEndの時刻で、終日の期日（UTCの0時で保存）はToday・WeekStartDate・WeekEndDateで比較する
+type TaskStatsBounds struct {
+	Now           time.Time
+	Today         time.Time
+	WeekStart     time.Time
+	WeekEnd       time.Time
+	WeekStartDate time.Time
+	WeekEndDate   time.Time
+}
+
+// NewTaskStatsBoundsはlocでのnowを基準に判定基準を作成
+// 今週はlocでの月曜0時から翌週の月曜0時まで（期限切れの判定はTask.IsOverdueと同じ）
+func NewTaskStatsBounds(now time.Time, loc *time.Location) TaskStatsBounds {
+	local := now.In(loc)
+	y, m, d := local.Date()
+	// 月曜日からの経過日数（日曜日は6）
+	offset := (int(local.Weekday()) + 6) % 7
+	weekStart := time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+	weekEnd := time.Date(y, m, d-offset+7, 0, 0, 0, 0, loc)
+
+	return TaskStatsBounds{
+		Now:           now.UTC(),
+		Today:         time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		WeekStart:     weekStart.UTC(),
+		WeekEnd:       weekEnd.UTC(),
+		WeekStartDate: time.Date(y, m, d-offset, 0, 0, 0, 0, time.UTC),
+		WeekEndDate:   time.Date(y, m, d-offset+7, 0, 0, 0, 0, time.UTC),
+	}
+}
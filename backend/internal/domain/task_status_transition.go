@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// TaskStatusTransitionはタスクのステータス遷移の記録
+// 作成時は作成時点のステータスへの遷移としてFromStatusをnilで記録する
+type TaskStatusTransition struct {
+	ID         int64
+	TaskID     int64
+	FromStatus *TaskStatus
+	ToStatus   TaskStatus
+	// ChangedByは遷移させたユーザー（ユーザー削除後はnil）
+	ChangedBy      *int64
+	TransitionedAt time.Time
+}
+
+// NewTaskStatusTransitionはタスクの現在のステータスへの遷移を作成
+func NewTaskStatusTransition(clock Clock, task *Task, from *TaskStatus, changedBy int64) *TaskStatusTransition {
+	return &TaskStatusTransition{
+		TaskID:         task.ID,
+		FromStatus:     from,
+		ToStatus:       task.Status,
+		ChangedBy:      &changedBy,
+		TransitionedAt: clock.Now(),
+	}
+}
+
+// maxAnalyticsDaysは分析で一度に指定できる最大日数
+const maxAnalyticsDays = 366
+
+// TaskAnalyticsRangeは分析の対象期間（ユーザーのタイムゾーンでのFromDateの0時からToDateの翌日の0時まで）
+type TaskAnalyticsRange struct {
+	FromDate time.Time
+	ToDate   time.Time
+	Location *time.Location
+}
+
+// NewTaskAnalyticsRangeは日付（年月日のみ使用）の範囲から分析の対象期間を作成
+// 開始日が終了日より後、または366日を超える場合はErrInvalidDateRange
+func NewTaskAnalyticsRange(fromDate, toDate time.Time, loc *time.Location) (TaskAnalyticsRange, error) {
+	r := TaskAnalyticsRange{FromDate: dateOf(fromDate), ToDate: dateOf(toDate), Location: loc}
+	days := int(r.ToDate.Sub(r.FromDate).Hours()/24) + 1
+	if days < 1 || days > maxAnalyticsDays {
+		return TaskAnalyticsRange{}, ErrInvalidDateRange
+	}
+	return r, nil
+}
+
+// Startは期間の開始日時
+func (r TaskAnalyticsRange) Start() time.Time {
+	return r.startOfDay(r.FromDate)
+}
+
+// Endは期間の終了日時（この日時を含まない）
+func (r TaskAnalyticsRange) End() time.Time {
+	return r.startOfDay(r.ToDate.AddDate(0, 0, 1))
+}
+
+// Containsは日時が期間内かどうかを判定
+func (r TaskAnalyticsRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start()) && t.Before(r.End())
+}
+
+// startOfDayは日付（UTCの0時）のLocationでの0時
+func (r TaskAnalyticsRange) startOfDay(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, r.Location)
+}
+
+// dateOfは日時の年月日をUTCの0時にした日付
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// TaskFlowは1つのタスクのステータス遷移の履歴（遷移日時の昇順）
+type TaskFlow struct {
+	TaskID      int64
+	Transitions []*TaskStatusTransition
+}
+
+// GroupTaskFlowsはタスクID・遷移日時の順に並んだ遷移をタスクごとの履歴にまとめる
+func GroupTaskFlows(transitions []*TaskStatusTransition) []*TaskFlow {
+	var flows []*TaskFlow
+	for _, transition := range transitions {
+		if len(flows) == 0 || flows[len(flows)-1].TaskID != transition.TaskID {
+			flows = append(flows, &TaskFlow{TaskID: transition.TaskID})
+		}
+		flow := flows[len(flows)-1]
+		flow.Transitions = append(flow.Transitions, transition)
+	}
+	return flows
+}
+
+// CreatedAtはタスクの作成日時（最初の遷移の日時）
+func (f *TaskFlow) CreatedAt() time.Time {
+	return f.Transitions[0].TransitionedAt
+}
+
+// StartedAtは最初にIN_PROGRESSになった日時（一度も着手していない場合はnil）
+func (f *TaskFlow) StartedAt() *time.Time {
+	for _, transition := range f.Transitions {
+		if transition.ToStatus == TaskStatusIN_PROGRESS {
+			return &transition.TransitionedAt
+		}
+	}
+	return nil
+}
+
+// CompletedAtは現在完了している場合の最後にDONEになった日時（未完了の場合はnil）
+func (f *TaskFlow) CompletedAt() *time.Time {
+	last := f.Transitions[len(f.Transitions)-1]
+	if last.ToStatus != TaskStatusDONE {
+		return nil
+	}
+	return &last.TransitionedAt
+}
+
+// StatusAtは日時tの直前のステータス（tの時点でまだ作成されていない場合はfalse）
+func (f *TaskFlow) StatusAt(t time.Time) (TaskStatus, bool) {
+	var status TaskStatus
+	found := false
+	for _, transition := range f.Transitions {
+		if !transition.TransitionedAt.Before(t) {
+			break
+		}
+		status = transition.ToStatus
+		found = true
+	}
+	return status, found
+}
+
+// DurationStatsは所要時間の統計（Countが0の場合は他の値も0）
+// P85は85パーセンタイル（最近順位法）
+type DurationStats struct {
+	Count   int
+	Average time.Duration
+	Median  time.Duration
+	P85     time.Duration
+}
+
+// NewDurationStatsは所要時間の一覧から統計を計算
+func NewDurationStats(durations []time.Duration) DurationStats {
+	n := len(durations)
+	if n == 0 {
+		return DurationStats{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+
+	return DurationStats{
+		Count:   n,
+		Average: total / time.Duration(n),
+		Median:  median,
+		P85:     sorted[int(math.Ceil(0.85*float64(n)))-1],
+	}
+}
+
+// LeadTimesは期間内に完了したタスクの作成から完了までの時間
+func LeadTimes(flows []*TaskFlow, r TaskAnalyticsRange) []time.Duration {
+	var durations []time.Duration
+	for _, flow := range flows {
+		if completedAt := flow.CompletedAt(); completedAt != nil && r.Contains(*completedAt) {
+			durations = append(durations, completedAt.Sub(flow.CreatedAt()))
+		}
+	}
+	return durations
+}
+
+// CycleTimesは期間内に完了したタスクの最初の着手から完了までの時間（着手せずに完了したタスクは含まない）
+func CycleTimes(flows []*TaskFlow, r TaskAnalyticsRange) []time.Duration {
+	var durations []time.Duration
+	for _, flow := range flows {
+		completedAt := flow.CompletedAt()
+		startedAt := flow.StartedAt()
+		if completedAt != nil && startedAt != nil && r.Contains(*completedAt) {
+			durations = append(durations, completedAt.Sub(*startedAt))
+		}
+	}
+	return durations
+}
+
+// WeeklyThroughputは週（月曜日から）ごとの完了したタスク数
+type WeeklyThroughput struct {
+	WeekStart time.Time
+	Completed int
+}
+
+// Throughputは期間と重なる週ごとに、期間内に完了したタスク数を数える
+// 週の開始日はUTCの0時の日付で表す
+func Throughput(flows []*TaskFlow, r TaskAnalyticsRange) []WeeklyThroughput {
+	firstWeek := weekStartOf(r.FromDate)
+	weeks := []WeeklyThroughput{}
+	for week := firstWeek; !week.After(r.ToDate); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, WeeklyThroughput{WeekStart: week})
+	}
+
+	for _, flow := range flows {
+		completedAt := flow.CompletedAt()
+		if completedAt == nil || !r.Contains(*completedAt) {
+			continue
+		}
+		completedDate := dateOf(completedAt.In(r.Location))
+		index := int(completedDate.Sub(firstWeek).Hours()/24) / 7
+		weeks[index].Completed++
+	}
+	return weeks
+}
+
+// weekStartOfは日付を含む週の月曜日
+func weekStartOf(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// DailyStatusSnapshotはある日の終わり時点のステータスごとのタスク数
+type DailyStatusSnapshot struct {
+	Date   time.Time
+	Counts map[TaskStatus]int
+}
+
+// DailyStatusSnapshotsは期間内の日ごとに、その日の終わり時点のステータスごとのタスク数を数える（累積フロー図・バーンダウン用）
+// 日付はUTCの0時で表し、その日の終わりの時点で作成されていないタスクは数えない
+func DailyStatusSnapshots(flows []*TaskFlow, r TaskAnalyticsRange) []DailyStatusSnapshot {
+	var snapshots []DailyStatusSnapshot
+	for date := r.FromDate; !date.After(r.ToDate); date = date.AddDate(0, 0, 1) {
+		endOfDay := r.startOfDay(date.AddDate(0, 0, 1))
+		counts := map[TaskStatus]int{
+			TaskStatusTODO:        0,
+			TaskStatusIN_PROGRESS: 0,
+			TaskStatusDONE:        0,
+		}
+		for _, flow := range flows {
+			if status, ok := flow.StatusAt(endOfDay); ok {
+				counts[status]++
+			}
+		}
+		snapshots = append(snapshots, DailyStatusSnapshot{Date: date, Counts: counts})
+	}
+	return snapshots
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// hashTokenは秘密トークンのSHA-256ハッシュ（16進数）を返す
+// DBにはトークンそのものではなくこのハッシュを保存し、照合もハッシュで行う
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -11,12 +11,18 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Name         string
+	Timezone     string
 	TokenVersion int
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    *time.Time
+	// EmailVerifiedAtはメールアドレスを確認した日時（未確認の場合はnil）
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
 }
 
+// DefaultTimezoneはタイムゾーン未設定のユーザーに適用されるタイムゾーン
+const DefaultTimezone = "UTC"
+
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 
 // NewUser新しいユーザーを作成
@@ -26,6 +32,7 @@ func NewUser(clock Clock, email, name string) (*User, error) {
 	u := &User{
 		Email:        normalizeEmail(email),
 		Name:         strings.TrimSpace(name),
+		Timezone:     DefaultTimezone,
 		TokenVersion: 0,
 		CreatedAt:    now,
 		UpdatedAt:    now,
@@ -65,6 +72,21 @@ func (u *User) IncrementTokenVersion(clock Clock) {
 	u.UpdatedAt = clock.Now()
 }
 
+// IsEmailVerifiedはメールアドレスを確認済みかを判定
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// VerifyEmailはメールアドレスを確認済みにする（確認済みの場合は日時を変えない）
+func (u *User) VerifyEmail(clock Clock) {
+	if u.EmailVerifiedAt != nil {
+		return
+	}
+	now := clock.Now()
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+}
+
 // IsDeletedは削除フラグを確認
 func (u *User) IsDeleted() bool {
 	return u.DeletedAt != nil
@@ -76,6 +98,32 @@ func (u *User) SetPasswordHash(clock Clock, hash string) {
 	u.UpdatedAt = clock.Now()
 }
 
+// UpdateTimezoneはIANAタイムゾーン名（例: Asia/Tokyo）を検証して設定
+func (u *User) UpdateTimezone(clock Clock, timezone string) error {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return ErrInvalidTimezone
+	}
+	u.Timezone = timezone
+	u.UpdatedAt = clock.Now()
+	return nil
+}
+
+// Locationはユーザーのタイムゾーンを返す（不正な値の場合はUTC）
+func (u *User) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // normalizeEmailはメールアドレスを正規化
 func normalizeEmail(in string) string {
 	return strings.ToLower(strings.TrimSpace(in))
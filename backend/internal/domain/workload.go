@@ -0,0 +1,30 @@
+package domain
+
+// AssigneeWorkloadはユーザーに直接アサインされているタスクの件数（負荷の集計）
+type AssigneeWorkload struct {
+	UserID     int64
+	Name       string
+	Email      string
+	Total      int
+	ByPriority map[int]int
+	Overdue    int
+}
+
+// NewAssigneeWorkloadはすべての優先度の件数を0とした集計を作成
+func NewAssigneeWorkload(userID int64, name, email string) *AssigneeWorkload {
+	workload := &AssigneeWorkload{
+		UserID:     userID,
+		Name:       name,
+		Email:      email,
+		ByPriority: make(map[int]int, maxPriority+1),
+	}
+	for priority := 0; priority <= maxPriority; priority++ {
+		workload.ByPriority[priority] = 0
+	}
+	return workload
+}
+
+// OpenTaskStatusesは未完了のタスクのステータス
+func OpenTaskStatuses() []TaskStatus {
+	return []TaskStatus{TaskStatusTODO, TaskStatusIN_PROGRESS}
+}
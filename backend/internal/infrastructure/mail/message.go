@@ -0,0 +1,25 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// buildMessageはメールをRFC 5322形式のメッセージに変換する（件名はMIMEエンコード、本文はUTF-8のプレーンテキスト）
+func buildMessage(from string, m domain.Mail, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", m.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(m.Body)
+	return buf.Bytes()
+}
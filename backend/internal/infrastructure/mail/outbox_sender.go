@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// OutboxSenderはメールを送らずにディレクトリへ.emlファイルとして書き出すdomain.MailSenderの実装（ローカル開発用）
+type OutboxSender struct {
+	dir   string
+	from  string
+	clock domain.Clock
+}
+
+// NewOutboxSenderで新しいOutboxSenderを作成
+func NewOutboxSender(dir, from string, clock domain.Clock) domain.MailSender {
+	return &OutboxSender{
+		dir:   dir,
+		from:  from,
+		clock: clock,
+	}
+}
+
+// Sendはメールを「送信日時-ランダム文字列.eml」というファイル名で書き出す
+func (s *OutboxSender) Send(ctx context.Context, m domain.Mail) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("failed to generate outbox file name: %w", err)
+	}
+	now := s.clock.Now()
+	path := filepath.Join(s.dir, now.UTC().Format("20060102T150405")+"-"+hex.EncodeToString(b)+".eml")
+
+	if err := os.WriteFile(path, buildMessage(s.from, m, now), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail to outbox: %w", err)
+	}
+
+	log.Printf("[MAIL] subject=%q written to %s", m.Subject, path)
+	return nil
+}
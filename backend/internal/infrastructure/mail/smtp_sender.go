@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// SMTPSenderはSMTPサーバー経由でメールを送るdomain.MailSenderの実装
+type SMTPSender struct {
+	addr  string
+	from  string
+	auth  smtp.Auth
+	clock domain.Clock
+}
+
+// NewSMTPSenderで新しいSMTPSenderを作成
+// usernameが空の場合は認証なしで送信する（ローカルの開発用SMTPサーバーなど）
+func NewSMTPSender(addr, from, username, password string, clock domain.Clock) domain.MailSender {
+	var auth smtp.Auth
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPSender{
+		addr:  addr,
+		from:  from,
+		auth:  auth,
+		clock: clock,
+	}
+}
+
+// SendはSMTPサーバーにメールを送る
+func (s *SMTPSender) Send(ctx context.Context, m domain.Mail) error {
+	msg := buildMessage(s.from, m, s.clock.Now())
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{m.To}, msg); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
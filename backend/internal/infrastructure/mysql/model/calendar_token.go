@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// CalendarTokenはcalendar_tokensテーブルの構造を表す
+type CalendarToken struct {
+	UserID    int64
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *CalendarToken) ToDomain() *domain.CalendarToken {
+	return &domain.CalendarToken{
+		UserID:    m.UserID,
+		TokenHash: m.TokenHash,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// CalendarTokenFromDomainはドメインエンティティをDBモデルに変換
+func CalendarTokenFromDomain(t *domain.CalendarToken) *CalendarToken {
+	return &CalendarToken{
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		CreatedAt: t.CreatedAt,
+	}
+}
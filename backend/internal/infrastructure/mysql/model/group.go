@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// Groupはuser_groupsテーブルの構造を表す
+type Group struct {
+	ID        int64
+	Name      string
+	CreatedBy int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *Group) ToDomain() *domain.Group {
+	return &domain.Group{
+		ID:        m.ID,
+		Name:      m.Name,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// GroupFromDomainはドメインエンティティをDBモデルに変換
+func GroupFromDomain(g *domain.Group) *Group {
+	return &Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		CreatedBy: g.CreatedBy,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+// GroupMemberはgroup_membersテーブルの構造を表す
+type GroupMember struct {
+	GroupID   int64
+	UserID    int64
+	AddedBy   int64
+	CreatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *GroupMember) ToDomain() *domain.GroupMember {
+	return &domain.GroupMember{
+		GroupID:   m.GroupID,
+		UserID:    m.UserID,
+		AddedBy:   m.AddedBy,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// GroupMemberFromDomainはドメインエンティティをDBモデルに変換
+func GroupMemberFromDomain(gm *domain.GroupMember) *GroupMember {
+	return &GroupMember{
+		GroupID:   gm.GroupID,
+		UserID:    gm.UserID,
+		AddedBy:   gm.AddedBy,
+		CreatedAt: gm.CreatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// IdempotencyRecordはidempotency_keysテーブルの構造を表す
+type IdempotencyRecord struct {
+	UserID          int64
+	Key             string
+	Fingerprint     string
+	StatusCode      sql.NullInt64
+	ResponseHeaders []byte
+	ResponseBody    []byte
+	ResourceID      sql.NullInt64
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *IdempotencyRecord) ToDomain() (*domain.IdempotencyRecord, error) {
+	var headers map[string]string
+	if len(m.ResponseHeaders) > 0 {
+		if err := json.Unmarshal(m.ResponseHeaders, &headers); err != nil {
+			return nil, err
+		}
+	}
+
+	var resourceID *int64
+	if m.ResourceID.Valid {
+		resourceID = &m.ResourceID.Int64
+	}
+
+	return &domain.IdempotencyRecord{
+		UserID:          m.UserID,
+		Key:             m.Key,
+		Fingerprint:     m.Fingerprint,
+		StatusCode:      int(m.StatusCode.Int64),
+		ResponseHeaders: headers,
+		ResponseBody:    m.ResponseBody,
+		ResourceID:      resourceID,
+		CreatedAt:       m.CreatedAt,
+		ExpiresAt:       m.ExpiresAt,
+	}, nil
+}
+
+// IdempotencyRecordFromDomainはドメインエンティティをDBモデルに変換
+// 処理中（StatusCodeが0）の記録はレスポンスの列をNULLにする
+func IdempotencyRecordFromDomain(r *domain.IdempotencyRecord) (*IdempotencyRecord, error) {
+	m := &IdempotencyRecord{
+		UserID:      r.UserID,
+		Key:         r.Key,
+		Fingerprint: r.Fingerprint,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+	if !r.IsCompleted() {
+		return m, nil
+	}
+
+	headers, err := json.Marshal(r.ResponseHeaders)
+	if err != nil {
+		return nil, err
+	}
+	m.StatusCode = sql.NullInt64{Int64: int64(r.StatusCode), Valid: true}
+	m.ResponseHeaders = headers
+	m.ResponseBody = r.ResponseBody
+	if m.ResponseBody == nil {
+		m.ResponseBody = []byte{}
+	}
+	if r.ResourceID != nil {
+		m.ResourceID = sql.NullInt64{Int64: *r.ResourceID, Valid: true}
+	}
+	return m, nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// PasswordResetTokenはpassword_reset_tokensテーブルの構造を表す
+type PasswordResetToken struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *PasswordResetToken) ToDomain() *domain.PasswordResetToken {
+	return &domain.PasswordResetToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// PasswordResetTokenFromDomainはドメインエンティティをDBモデルに変換
+func PasswordResetTokenFromDomain(t *domain.PasswordResetToken) *PasswordResetToken {
+	return &PasswordResetToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// RefreshTokenはrefresh_tokensテーブルの構造を表す
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	SessionID int64
+	TokenHash string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	CreatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *RefreshToken) ToDomain() *domain.RefreshToken {
+	return &domain.RefreshToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		SessionID: m.SessionID,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		RotatedAt: m.RotatedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// RefreshTokenFromDomainはドメインエンティティをDBモデルに変換
+func RefreshTokenFromDomain(t *domain.RefreshToken) *RefreshToken {
+	return &RefreshToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		SessionID: t.SessionID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		RotatedAt: t.RotatedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// SavedViewはsaved_viewsテーブルの構造を表す
+type SavedView struct {
+	ID        int64
+	OwnerID   int64
+	Name      string
+	Filter    []byte
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// savedViewFilterはsaved_views.filterに保存するJSONの構造
+// ドメインの型から独立させ、保存済みのJSONの互換性を保つ
+type savedViewFilter struct {
+	Statuses      []string   `json:"statuses,omitempty"`
+	PriorityMin   *int       `json:"priorityMin,omitempty"`
+	PriorityMax   *int       `json:"priorityMax,omitempty"`
+	DueFrom       *time.Time `json:"dueFrom,omitempty"`
+	DueTo         *time.Time `json:"dueTo,omitempty"`
+	CreatedFrom   *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo     *time.Time `json:"createdTo,omitempty"`
+	UpdatedFrom   *time.Time `json:"updatedFrom,omitempty"`
+	UpdatedTo     *time.Time `json:"updatedTo,omitempty"`
+	Overdue       *bool      `json:"overdue,omitempty"`
+	Role          string     `json:"role,omitempty"`
+	AssigneeID    *int64     `json:"assigneeId,omitempty"`
+	SortKey       string     `json:"sortKey"`
+	SortDirection string     `json:"sortDirection"`
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *SavedView) ToDomain() (*domain.SavedView, error) {
+	var f savedViewFilter
+	if err := json.Unmarshal(m.Filter, &f); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]domain.TaskStatus, len(f.Statuses))
+	for i, status := range f.Statuses {
+		statuses[i] = domain.TaskStatus(status)
+	}
+
+	return &domain.SavedView{
+		ID:      m.ID,
+		OwnerID: m.OwnerID,
+		Name:    m.Name,
+		Filter: domain.TaskFilter{
+			Statuses:      statuses,
+			PriorityMin:   f.PriorityMin,
+			PriorityMax:   f.PriorityMax,
+			DueFrom:       f.DueFrom,
+			DueTo:         f.DueTo,
+			CreatedFrom:   f.CreatedFrom,
+			CreatedTo:     f.CreatedTo,
+			UpdatedFrom:   f.UpdatedFrom,
+			UpdatedTo:     f.UpdatedTo,
+			Overdue:       f.Overdue,
+			Role:          domain.TaskRole(f.Role),
+			AssigneeID:    f.AssigneeID,
+			SortKey:       domain.TaskSortKey(f.SortKey),
+			SortDirection: domain.SortDirection(f.SortDirection),
+		},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
+
+// SavedViewFromDomainはドメインエンティティをDBモデルに変換
+func SavedViewFromDomain(v *domain.SavedView) (*SavedView, error) {
+	statuses := make([]string, len(v.Filter.Statuses))
+	for i, status := range v.Filter.Statuses {
+		statuses[i] = string(status)
+	}
+
+	filter, err := json.Marshal(savedViewFilter{
+		Statuses:      statuses,
+		PriorityMin:   v.Filter.PriorityMin,
+		PriorityMax:   v.Filter.PriorityMax,
+		DueFrom:       v.Filter.DueFrom,
+		DueTo:         v.Filter.DueTo,
+		CreatedFrom:   v.Filter.CreatedFrom,
+		CreatedTo:     v.Filter.CreatedTo,
+		UpdatedFrom:   v.Filter.UpdatedFrom,
+		UpdatedTo:     v.Filter.UpdatedTo,
+		Overdue:       v.Filter.Overdue,
+		Role:          string(v.Filter.Role),
+		AssigneeID:    v.Filter.AssigneeID,
+		SortKey:       string(v.Filter.SortKey),
+		SortDirection: string(v.Filter.SortDirection),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SavedView{
+		ID:        v.ID,
+		OwnerID:   v.OwnerID,
+		Name:      v.Name,
+		Filter:    filter,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}, nil
+}
+
+// SavedViewShareはsaved_view_sharesテーブルの構造を表す
+type SavedViewShare struct {
+	ViewID    int64
+	UserID    int64
+	SharedBy  int64
+	CreatedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *SavedViewShare) ToDomain() *domain.SavedViewShare {
+	return &domain.SavedViewShare{
+		ViewID:    m.ViewID,
+		UserID:    m.UserID,
+		SharedBy:  m.SharedBy,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// SavedViewShareFromDomainはドメインエンティティをDBモデルに変換
+func SavedViewShareFromDomain(s *domain.SavedViewShare) *SavedViewShare {
+	return &SavedViewShare{
+		ViewID:    s.ViewID,
+		UserID:    s.UserID,
+		SharedBy:  s.SharedBy,
+		CreatedAt: s.CreatedAt,
+	}
+}
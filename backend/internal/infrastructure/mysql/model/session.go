@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// Sessionはsessionsテーブルの構造を表す
+type Session struct {
+	ID         int64
+	UserID     int64
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *Session) ToDomain() *domain.Session {
+	return &domain.Session{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		UserAgent:  m.UserAgent,
+		IPAddress:  m.IPAddress,
+		CreatedAt:  m.CreatedAt,
+		LastUsedAt: m.LastUsedAt,
+		ExpiresAt:  m.ExpiresAt,
+		RevokedAt:  m.RevokedAt,
+	}
+}
+
+// SessionFromDomainはドメインエンティティをDBモデルに変換
+func SessionFromDomain(s *domain.Session) *Session {
+	return &Session{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+	}
+}
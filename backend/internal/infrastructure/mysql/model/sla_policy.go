@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// SLAPolicyはsla_policiesテーブルの構造を表す
+type SLAPolicy struct {
+	Priority              int
+	StartWithinMinutes    int
+	ResolveWithinMinutes  int
+	EscalateBeforeMinutes int
+	EscalationAction      string
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *SLAPolicy) ToDomain() *domain.SLAPolicy {
+	return &domain.SLAPolicy{
+		Priority:         m.Priority,
+		StartWithin:      time.Duration(m.StartWithinMinutes) * time.Minute,
+		ResolveWithin:    time.Duration(m.ResolveWithinMinutes) * time.Minute,
+		EscalateBefore:   time.Duration(m.EscalateBeforeMinutes) * time.Minute,
+		EscalationAction: domain.SLAEscalationAction(m.EscalationAction),
+	}
+}
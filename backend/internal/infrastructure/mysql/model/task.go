@@ -8,16 +8,22 @@ import (
 
 // Taskはtasksテーブルの構造を現す
 type Task struct {
-	ID          int64
-	OwnerID     int64
-	Title       string
-	Description *string
-	DueDate     *time.Time
-	Status      string
-	Priority    int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   *time.Time
+	ID             int64
+	OwnerID        int64
+	Title          string
+	Description    *string
+	StartDate      *time.Time
+	DueDate        *time.Time
+	DueAllDay      bool
+	Status         string
+	Priority       int
+	StartedAt      *time.Time
+	CompletedAt    *time.Time
+	SLAEscalatedAt *time.Time
+	Version        int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
 }
 
 // ToDomainはDBモデルをドメインエンティティに変換
@@ -29,16 +35,22 @@ func (m *Task) ToDomain() *domain.Task {
 	}
 
 	return &domain.Task{
-		ID:          m.ID,
-		OwnerID:     m.OwnerID,
-		Title:       m.Title,
-		Description: m.Description,
-		DueDate:     m.DueDate,
-		Status:      status,
-		Priority:    m.Priority,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
-		DeletedAt:   m.DeletedAt,
+		ID:             m.ID,
+		OwnerID:        m.OwnerID,
+		Title:          m.Title,
+		Description:    m.Description,
+		StartDate:      utcTime(m.StartDate),
+		DueDate:        utcTime(m.DueDate),
+		DueAllDay:      m.DueAllDay,
+		Status:         status,
+		Priority:       m.Priority,
+		StartedAt:      utcTime(m.StartedAt),
+		CompletedAt:    utcTime(m.CompletedAt),
+		SLAEscalatedAt: utcTime(m.SLAEscalatedAt),
+		Version:        m.Version,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		DeletedAt:      m.DeletedAt,
 	}
 }
 
@@ -52,15 +64,30 @@ func isValidStatus(status domain.TaskStatus) bool {
 // TaskFromDomainはドメインエンティティをDBモデルに変換
 func TaskFromDomain(t *domain.Task) *Task {
 	return &Task{
-		ID:          t.ID,
-		OwnerID:     t.OwnerID,
-		Title:       t.Title,
-		Description: t.Description,
-		DueDate:     t.DueDate,
-		Status:      string(t.Status),
-		Priority:    t.Priority,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
-		DeletedAt:   t.DeletedAt,
+		ID:             t.ID,
+		OwnerID:        t.OwnerID,
+		Title:          t.Title,
+		Description:    t.Description,
+		StartDate:      utcTime(t.StartDate),
+		DueDate:        utcTime(t.DueDate),
+		DueAllDay:      t.DueAllDay,
+		Status:         string(t.Status),
+		Priority:       t.Priority,
+		StartedAt:      utcTime(t.StartedAt),
+		CompletedAt:    utcTime(t.CompletedAt),
+		SLAEscalatedAt: utcTime(t.SLAEscalatedAt),
+		Version:        t.Version,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		DeletedAt:      t.DeletedAt,
 	}
 }
+
+// utcTimeは時刻をUTCに揃える（DATETIME列はタイムゾーンを持たないため、常にUTCで読み書きする）
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
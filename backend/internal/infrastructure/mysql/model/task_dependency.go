@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// TaskDependencyはtask_dependenciesテーブルの構造を表す
+type TaskDependency struct {
+	TaskID          int64
+	DependsOnTaskID int64
+	CreatedBy       int64
+	CreatedAt       time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *TaskDependency) ToDomain() *domain.TaskDependency {
+	return &domain.TaskDependency{
+		TaskID:          m.TaskID,
+		DependsOnTaskID: m.DependsOnTaskID,
+		CreatedBy:       m.CreatedBy,
+		CreatedAt:       m.CreatedAt,
+	}
+}
+
+// TaskDependencyFromDomainはドメインエンティティをDBモデルに変換
+func TaskDependencyFromDomain(d *domain.TaskDependency) *TaskDependency {
+	return &TaskDependency{
+		TaskID:          d.TaskID,
+		DependsOnTaskID: d.DependsOnTaskID,
+		CreatedBy:       d.CreatedBy,
+		CreatedAt:       d.CreatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// TaskGroupAssigneeはtask_group_assigneesテーブルの構造を表す
+type TaskGroupAssignee struct {
+	TaskID     int64
+	GroupID    int64
+	AssignedBy int64
+	CreatedAt  time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *TaskGroupAssignee) ToDomain() *domain.TaskGroupAssignee {
+	return &domain.TaskGroupAssignee{
+		TaskID:     m.TaskID,
+		GroupID:    m.GroupID,
+		AssignedBy: m.AssignedBy,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// TaskGroupAssigneeFromDomainはドメインエンティティをDBモデルに変換
+func TaskGroupAssigneeFromDomain(tga *domain.TaskGroupAssignee) *TaskGroupAssignee {
+	return &TaskGroupAssignee{
+		TaskID:     tga.TaskID,
+		GroupID:    tga.GroupID,
+		AssignedBy: tga.AssignedBy,
+		CreatedAt:  tga.CreatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// TaskRevisionはtask_revisionsテーブルの構造を表す
+type TaskRevision struct {
+	TaskID           int64
+	Revision         int64
+	Title            string
+	Description      *string
+	StartDate        *time.Time
+	DueDate          *time.Time
+	DueAllDay        bool
+	Status           string
+	Priority         int
+	AssigneeIDs      []byte
+	AssigneeGroupIDs []byte
+	ChangedBy        *int64
+	CreatedAt        time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *TaskRevision) ToDomain() (*domain.TaskRevision, error) {
+	assigneeIDs := []int64{}
+	if err := json.Unmarshal(m.AssigneeIDs, &assigneeIDs); err != nil {
+		return nil, err
+	}
+	groupIDs := []int64{}
+	if err := json.Unmarshal(m.AssigneeGroupIDs, &groupIDs); err != nil {
+		return nil, err
+	}
+
+	return &domain.TaskRevision{
+		TaskID:           m.TaskID,
+		Revision:         m.Revision,
+		Title:            m.Title,
+		Description:      m.Description,
+		StartDate:        utcTime(m.StartDate),
+		DueDate:          utcTime(m.DueDate),
+		DueAllDay:        m.DueAllDay,
+		Status:           domain.TaskStatus(m.Status),
+		Priority:         m.Priority,
+		AssigneeIDs:      assigneeIDs,
+		AssigneeGroupIDs: groupIDs,
+		ChangedBy:        m.ChangedBy,
+		CreatedAt:        m.CreatedAt,
+	}, nil
+}
+
+// TaskRevisionFromDomainはドメインエンティティをDBモデルに変換
+func TaskRevisionFromDomain(r *domain.TaskRevision) (*TaskRevision, error) {
+	assigneeIDs, err := json.Marshal(nonNilIDs(r.AssigneeIDs))
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, err := json.Marshal(nonNilIDs(r.AssigneeGroupIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskRevision{
+		TaskID:           r.TaskID,
+		Revision:         r.Revision,
+		Title:            r.Title,
+		Description:      r.Description,
+		StartDate:        utcTime(r.StartDate),
+		DueDate:          utcTime(r.DueDate),
+		DueAllDay:        r.DueAllDay,
+		Status:           string(r.Status),
+		Priority:         r.Priority,
+		AssigneeIDs:      assigneeIDs,
+		AssigneeGroupIDs: groupIDs,
+		ChangedBy:        r.ChangedBy,
+		CreatedAt:        r.CreatedAt,
+	}, nil
+}
+
+// nonNilIDsはJSONでnullではなく空の配列として保存するため、nilを空のスライスにする
+func nonNilIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// TaskShareはtask_sharesテーブルの構造を表す
+type TaskShare struct {
+	TaskID     int64
+	UserID     int64
+	Permission string
+	SharedBy   int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *TaskShare) ToDomain() *domain.TaskShare {
+	return &domain.TaskShare{
+		TaskID:     m.TaskID,
+		UserID:     m.UserID,
+		Permission: domain.SharePermission(m.Permission),
+		SharedBy:   m.SharedBy,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+// TaskShareFromDomainはドメインエンティティをDBモデルに変換
+func TaskShareFromDomain(ts *domain.TaskShare) *TaskShare {
+	return &TaskShare{
+		TaskID:     ts.TaskID,
+		UserID:     ts.UserID,
+		Permission: string(ts.Permission),
+		SharedBy:   ts.SharedBy,
+		CreatedAt:  ts.CreatedAt,
+		UpdatedAt:  ts.UpdatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// TaskStatusTransitionはtask_status_transitionsテーブルの構造を表す
+type TaskStatusTransition struct {
+	ID             int64
+	TaskID         int64
+	FromStatus     *string
+	ToStatus       string
+	ChangedBy      *int64
+	TransitionedAt time.Time
+}
+
+// ToDomainはDBモデルをドメインエンティティに変換
+func (m *TaskStatusTransition) ToDomain() *domain.TaskStatusTransition {
+	var from *domain.TaskStatus
+	if m.FromStatus != nil {
+		status := domain.TaskStatus(*m.FromStatus)
+		from = &status
+	}
+
+	return &domain.TaskStatusTransition{
+		ID:             m.ID,
+		TaskID:         m.TaskID,
+		FromStatus:     from,
+		ToStatus:       domain.TaskStatus(m.ToStatus),
+		ChangedBy:      m.ChangedBy,
+		TransitionedAt: m.TransitionedAt,
+	}
+}
+
+// TaskStatusTransitionFromDomainはドメインエンティティをDBモデルに変換
+func TaskStatusTransitionFromDomain(t *domain.TaskStatusTransition) *TaskStatusTransition {
+	var from *string
+	if t.FromStatus != nil {
+		status := string(*t.FromStatus)
+		from = &status
+	}
+
+	return &TaskStatusTransition{
+		ID:             t.ID,
+		TaskID:         t.TaskID,
+		FromStatus:     from,
+		ToStatus:       string(t.ToStatus),
+		ChangedBy:      t.ChangedBy,
+		TransitionedAt: t.TransitionedAt,
+	}
+}
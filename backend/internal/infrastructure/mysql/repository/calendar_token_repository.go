@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type calendarTokenRepository struct{}
+
+// NewCalendarTokenRepository は新しい CalendarTokenRepository 実装を作成します
+func NewCalendarTokenRepository() domain.CalendarTokenRepository {
+	return &calendarTokenRepository{}
+}
+
+// Upsert はユーザーのトークンを作成します（既にある場合は置き換えます）
+func (r *calendarTokenRepository) Upsert(ctx context.Context, ex domain.Executor, token *domain.CalendarToken) error {
+	m := model.CalendarTokenFromDomain(token)
+
+	query := `
+		INSERT INTO calendar_tokens (user_id, token_hash, created_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE token_hash = VALUES(token_hash), created_at = VALUES(created_at)
+	`
+
+	_, err := ex.ExecContext(ctx, query, m.UserID, m.TokenHash, m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert calendar token: %w", err)
+	}
+
+	return nil
+}
+
+// FindByTokenHash はトークンのハッシュからトークンを取得します
+func (r *calendarTokenRepository) FindByTokenHash(ctx context.Context, ex domain.Executor, tokenHash string) (*domain.CalendarToken, error) {
+	query := `
+		SELECT user_id, token_hash, created_at
+		FROM calendar_tokens
+		WHERE token_hash = ?
+	`
+
+	var m model.CalendarToken
+	err := ex.QueryRowContext(ctx, query, tokenHash).Scan(&m.UserID, &m.TokenHash, &m.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCalendarTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to find calendar token: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+// DeleteByUserID はユーザーのトークンを削除します
+func (r *calendarTokenRepository) DeleteByUserID(ctx context.Context, ex domain.Executor, userID int64) error {
+	query := `
+		DELETE FROM calendar_tokens
+		WHERE user_id = ?
+	`
+
+	_, err := ex.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "strings"
+
+// isDuplicateEntryErrorはMySQLの Duplicate entry エラー（Error 1062）かどうかを判定する
+func isDuplicateEntryError(err error) bool {
+	return strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "Error 1062")
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type groupMemberRepository struct{}
+
+// NewGroupMemberRepositoryは新しいGroupMemberRepository実装を作成する
+func NewGroupMemberRepository() domain.GroupMemberRepository {
+	return &groupMemberRepository{}
+}
+
+// Createはグループにメンバーを追加する
+func (r *groupMemberRepository) Create(ctx context.Context, ex domain.Executor, member *domain.GroupMember) error {
+	m := model.GroupMemberFromDomain(member)
+
+	query := `
+		INSERT INTO group_members (group_id, user_id, added_by, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	_, err := ex.ExecContext(ctx, query,
+		m.GroupID,
+		m.UserID,
+		m.AddedBy,
+		m.CreatedAt,
+	)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrDuplicateGroupMember
+		}
+		return fmt.Errorf("failed to create group member: %w", err)
+	}
+
+	return nil
+}
+
+// Deleteはグループからメンバーを削除する
+func (r *groupMemberRepository) Delete(ctx context.Context, ex domain.Executor, groupID, userID int64) error {
+	query := `
+		DELETE FROM group_members
+		WHERE group_id = ? AND user_id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group member: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrGroupMemberNotFound
+	}
+
+	return nil
+}
+
+// FindByGroupIDはグループのメンバー一覧を取得する
+func (r *groupMemberRepository) FindByGroupID(ctx context.Context, ex domain.Executor, groupID int64) ([]*domain.GroupMember, error) {
+	query := `
+		SELECT group_id, user_id, added_by, created_at
+		FROM group_members
+		WHERE group_id = ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group members: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var members []*domain.GroupMember
+	for rows.Next() {
+		var m model.GroupMember
+		err := rows.Scan(
+			&m.GroupID,
+			&m.UserID,
+			&m.AddedBy,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		members = append(members, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group members: %w", err)
+	}
+
+	return members, nil
+}
+
+// FindGroupIDsByUserIDはユーザーが所属するグループIDの一覧を取得する
+func (r *groupMemberRepository) FindGroupIDsByUserID(ctx context.Context, ex domain.Executor, userID int64) ([]int64, error) {
+	query := `
+		SELECT group_id
+		FROM group_members
+		WHERE user_id = ?
+	`
+
+	rows, err := ex.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group ids: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var groupIDs []int64
+	for rows.Next() {
+		var groupID int64
+		if err := rows.Scan(&groupID); err != nil {
+			return nil, fmt.Errorf("failed to scan group id: %w", err)
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group ids: %w", err)
+	}
+
+	return groupIDs, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type groupRepository struct{}
+
+// NewGroupRepositoryは新しいGroupRepository実装を作成する
+func NewGroupRepository() domain.GroupRepository {
+	return &groupRepository{}
+}
+
+// Createは新しいグループをデータベースに挿入する
+func (r *groupRepository) Create(ctx context.Context, ex domain.Executor, group *domain.Group) error {
+	m := model.GroupFromDomain(group)
+
+	query := `
+		INSERT INTO user_groups (name, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query,
+		m.Name,
+		m.CreatedBy,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create group: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	group.ID = id
+	return nil
+}
+
+// FindByIDはIDでグループを取得する
+func (r *groupRepository) FindByID(ctx context.Context, ex domain.Executor, groupID int64) (*domain.Group, error) {
+	query := `
+		SELECT id, name, created_by, created_at, updated_at
+		FROM user_groups
+		WHERE id = ?
+	`
+
+	row := ex.QueryRowContext(ctx, query, groupID)
+
+	var m model.Group
+	err := row.Scan(
+		&m.ID,
+		&m.Name,
+		&m.CreatedBy,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, fmt.Errorf("failed to find group by id: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+// FindAllは全グループを取得する
+func (r *groupRepository) FindAll(ctx context.Context, ex domain.Executor) ([]*domain.Group, error) {
+	query := `
+		SELECT id, name, created_by, created_at, updated_at
+		FROM user_groups
+		ORDER BY name ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find all groups: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var groups []*domain.Group
+	for rows.Next() {
+		var m model.Group
+		err := rows.Scan(
+			&m.ID,
+			&m.Name,
+			&m.CreatedBy,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+		groups = append(groups, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// Updateは既存のグループを更新する
+func (r *groupRepository) Update(ctx context.Context, ex domain.Executor, group *domain.Group) error {
+	m := model.GroupFromDomain(group)
+
+	query := `
+		UPDATE user_groups
+		SET name = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.Name, m.UpdatedAt, m.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update group: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
+
+// Deleteはグループを削除する（メンバーとタスクへのアサインはCASCADEで削除される）
+func (r *groupRepository) Delete(ctx context.Context, ex domain.Executor, groupID int64) error {
+	query := `
+		DELETE FROM user_groups
+		WHERE id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
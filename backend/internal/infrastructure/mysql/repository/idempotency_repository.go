@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type idempotencyRepository struct{}
+
+// NewIdempotencyRepositoryは新しいIdempotencyRepository実装を作成する
+func NewIdempotencyRepository() domain.IdempotencyRepository {
+	return &idempotencyRepository{}
+}
+
+// Createは処理中の冪等キーを登録する
+// 同じキーが登録済みの場合（同時に送られたリトライなど）はErrIdempotencyKeyInUseを返す
+func (r *idempotencyRepository) Create(ctx context.Context, ex domain.Executor, record *domain.IdempotencyRecord) error {
+	m, err := model.IdempotencyRecordFromDomain(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+
+	query := `
+		INSERT INTO idempotency_keys (user_id, idempotency_key, fingerprint, status_code, response_headers, response_body, resource_id, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err = ex.ExecContext(ctx, query,
+		m.UserID,
+		m.Key,
+		m.Fingerprint,
+		m.StatusCode,
+		m.ResponseHeaders,
+		m.ResponseBody,
+		m.ResourceID,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrIdempotencyKeyInUse
+		}
+		return fmt.Errorf("failed to create idempotency record: %w", err)
+	}
+
+	return nil
+}
+
+// FindByKeyはユーザーと冪等キーで記録を取得する
+func (r *idempotencyRepository) FindByKey(ctx context.Context, ex domain.Executor, userID int64, key string) (*domain.IdempotencyRecord, error) {
+	query := `
+		SELECT user_id, idempotency_key, fingerprint, status_code, response_headers, response_body, resource_id, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ?
+	`
+
+	var m model.IdempotencyRecord
+	err := ex.QueryRowContext(ctx, query, userID, key).Scan(
+		&m.UserID,
+		&m.Key,
+		&m.Fingerprint,
+		&m.StatusCode,
+		&m.ResponseHeaders,
+		&m.ResponseBody,
+		&m.ResourceID,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find idempotency record: %w", err)
+	}
+
+	record, err := m.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency record: %w", err)
+	}
+	return record, nil
+}
+
+// Completeは処理中の記録にレスポンス（またはリソースのID）を保存する
+func (r *idempotencyRepository) Complete(ctx context.Context, ex domain.Executor, record *domain.IdempotencyRecord) error {
+	m, err := model.IdempotencyRecordFromDomain(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = ?, response_headers = ?, response_body = ?, resource_id = ?
+		WHERE user_id = ? AND idempotency_key = ? AND status_code IS NULL
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.StatusCode, m.ResponseHeaders, m.ResponseBody, m.ResourceID, m.UserID, m.Key)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency record: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Deleteは冪等キーの記録を削除する（存在しない場合は何もしない）
+func (r *idempotencyRepository) Delete(ctx context.Context, ex domain.Executor, userID int64, key string) error {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ?
+	`
+
+	if _, err := ex.ExecContext(ctx, query, userID, key); err != nil {
+		return fmt.Errorf("failed to delete idempotency record: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredは有効期限が切れた記録を削除し、削除した件数を返す
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, ex domain.Executor, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE expires_at <= ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency records: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
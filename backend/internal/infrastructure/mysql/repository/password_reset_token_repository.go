@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type passwordResetTokenRepository struct{}
+
+// NewPasswordResetTokenRepository は新しい PasswordResetTokenRepository 実装を作成します
+func NewPasswordResetTokenRepository() domain.PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{}
+}
+
+// Create はパスワード再設定トークンを保存します
+func (r *passwordResetTokenRepository) Create(ctx context.Context, ex domain.Executor, token *domain.PasswordResetToken) error {
+	m := model.PasswordResetTokenFromDomain(token)
+
+	query := `
+		INSERT INTO password_reset_tokens (user_id, token_hash, expires_at, used_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.UserID, m.TokenHash, m.ExpiresAt, m.UsedAt, m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	token.ID = id
+
+	return nil
+}
+
+// FindByTokenHash はトークンのハッシュからパスワード再設定トークンを取得します
+func (r *passwordResetTokenRepository) FindByTokenHash(ctx context.Context, ex domain.Executor, tokenHash string) (*domain.PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = ?
+	`
+
+	var m model.PasswordResetToken
+	err := ex.QueryRowContext(ctx, query, tokenHash).Scan(
+		&m.ID,
+		&m.UserID,
+		&m.TokenHash,
+		&m.ExpiresAt,
+		&m.UsedAt,
+		&m.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidResetToken
+		}
+		return nil, fmt.Errorf("failed to find password reset token: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+// MarkUsed はパスワード再設定トークンを使用済みにします
+// 既に使用済みの場合（同じトークンでの同時の再設定を含む）は ErrInvalidResetToken を返します
+func (r *passwordResetTokenRepository) MarkUsed(ctx context.Context, ex domain.Executor, tokenID int64, now time.Time) error {
+	query := `
+		UPDATE password_reset_tokens
+		SET used_at = ?
+		WHERE id = ? AND used_at IS NULL
+	`
+
+	result, err := ex.ExecContext(ctx, query, now, tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to mark password reset token as used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrInvalidResetToken
+	}
+
+	return nil
+}
+
+// DeleteUnusedByUserID はユーザーの未使用のパスワード再設定トークンを削除します（最後に送ったトークンのみ有効にするため）
+func (r *passwordResetTokenRepository) DeleteUnusedByUserID(ctx context.Context, ex domain.Executor, userID int64) error {
+	query := `
+		DELETE FROM password_reset_tokens
+		WHERE user_id = ? AND used_at IS NULL
+	`
+
+	_, err := ex.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete password reset tokens: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired は有効期限が切れたパスワード再設定トークンを削除し、削除した件数を返します
+func (r *passwordResetTokenRepository) DeleteExpired(ctx context.Context, ex domain.Executor, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM password_reset_tokens
+		WHERE expires_at <= ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired password reset tokens: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
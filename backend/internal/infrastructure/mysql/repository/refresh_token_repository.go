@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type refreshTokenRepository struct{}
+
+// NewRefreshTokenRepository は新しい RefreshTokenRepository 実装を作成します
+func NewRefreshTokenRepository() domain.RefreshTokenRepository {
+	return &refreshTokenRepository{}
+}
+
+// Create はリフレッシュトークンを保存します
+func (r *refreshTokenRepository) Create(ctx context.Context, ex domain.Executor, token *domain.RefreshToken) error {
+	m := model.RefreshTokenFromDomain(token)
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, session_id, token_hash, expires_at, rotated_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.UserID, m.SessionID, m.TokenHash, m.ExpiresAt, m.RotatedAt, m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	token.ID = id
+
+	return nil
+}
+
+// FindByTokenHash はトークンのハッシュからリフレッシュトークンを取得します
+func (r *refreshTokenRepository) FindByTokenHash(ctx context.Context, ex domain.Executor, tokenHash string) (*domain.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, session_id, token_hash, expires_at, rotated_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = ?
+	`
+
+	var m model.RefreshToken
+	err := ex.QueryRowContext(ctx, query, tokenHash).Scan(
+		&m.ID,
+		&m.UserID,
+		&m.SessionID,
+		&m.TokenHash,
+		&m.ExpiresAt,
+		&m.RotatedAt,
+		&m.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRefreshTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+// MarkRotated はリフレッシュトークンを置き換え済みにします
+// 既に置き換え済みの場合（同じトークンでの同時の再発行を含む）は ErrRefreshTokenReused を返します
+func (r *refreshTokenRepository) MarkRotated(ctx context.Context, ex domain.Executor, tokenID int64, now time.Time) error {
+	query := `
+		UPDATE refresh_tokens
+		SET rotated_at = ?
+		WHERE id = ? AND rotated_at IS NULL
+	`
+
+	result, err := ex.ExecContext(ctx, query, now, tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+
+	return nil
+}
+
+// DeleteExpired は有効期限が切れたリフレッシュトークンを削除し、削除した件数を返します
+func (r *refreshTokenRepository) DeleteExpired(ctx context.Context, ex domain.Executor, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE expires_at <= ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type savedViewRepository struct{}
+
+// NewSavedViewRepositoryは新しいSavedViewRepository実装を作成する
+func NewSavedViewRepository() domain.SavedViewRepository {
+	return &savedViewRepository{}
+}
+
+// Createは新しい保存済みビューを挿入する
+func (r *savedViewRepository) Create(ctx context.Context, ex domain.Executor, view *domain.SavedView) error {
+	m, err := model.SavedViewFromDomain(view)
+	if err != nil {
+		return fmt.Errorf("failed to encode view filter: %w", err)
+	}
+
+	query := `
+		INSERT INTO saved_views (owner_id, name, filter, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.OwnerID, m.Name, m.Filter, m.CreatedAt, m.UpdatedAt)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrDuplicateViewName
+		}
+		return fmt.Errorf("failed to create view: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	view.ID = id
+	return nil
+}
+
+// FindByIDはIDで保存済みビューを取得する
+func (r *savedViewRepository) FindByID(ctx context.Context, ex domain.Executor, viewID int64) (*domain.SavedView, error) {
+	query := `
+		SELECT id, owner_id, name, filter, created_at, updated_at
+		FROM saved_views
+		WHERE id = ?
+	`
+
+	var m model.SavedView
+	err := ex.QueryRowContext(ctx, query, viewID).Scan(
+		&m.ID,
+		&m.OwnerID,
+		&m.Name,
+		&m.Filter,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrViewNotFound
+		}
+		return nil, fmt.Errorf("failed to find view by id: %w", err)
+	}
+
+	view, err := m.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode view filter: %w", err)
+	}
+	return view, nil
+}
+
+// ListAccessibleByUserIDはユーザーが所有する、または共有されている保存済みビューを取得する
+func (r *savedViewRepository) ListAccessibleByUserID(ctx context.Context, ex domain.Executor, userID int64) ([]*domain.SavedView, error) {
+	query := `
+		SELECT id, owner_id, name, filter, created_at, updated_at
+		FROM saved_views
+		WHERE owner_id = ?
+		   OR EXISTS (
+		     SELECT 1
+		     FROM saved_view_shares
+		     WHERE saved_view_shares.view_id = saved_views.id
+		       AND saved_view_shares.user_id = ?
+		   )
+		ORDER BY name ASC, id ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, userID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var views []*domain.SavedView
+	for rows.Next() {
+		var m model.SavedView
+		err := rows.Scan(
+			&m.ID,
+			&m.OwnerID,
+			&m.Name,
+			&m.Filter,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan view: %w", err)
+		}
+		view, err := m.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode view filter: %w", err)
+		}
+		views = append(views, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating views: %w", err)
+	}
+
+	return views, nil
+}
+
+// Updateは保存済みビューの名前と絞り込み条件を更新する
+func (r *savedViewRepository) Update(ctx context.Context, ex domain.Executor, view *domain.SavedView) error {
+	m, err := model.SavedViewFromDomain(view)
+	if err != nil {
+		return fmt.Errorf("failed to encode view filter: %w", err)
+	}
+
+	query := `
+		UPDATE saved_views
+		SET name = ?, filter = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.Name, m.Filter, m.UpdatedAt, m.ID)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrDuplicateViewName
+		}
+		return fmt.Errorf("failed to update view: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrViewNotFound
+	}
+
+	return nil
+}
+
+// Deleteは保存済みビューを削除する（共有はON DELETE CASCADEで削除される）
+func (r *savedViewRepository) Delete(ctx context.Context, ex domain.Executor, viewID int64) error {
+	query := `
+		DELETE FROM saved_views
+		WHERE id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, viewID)
+	if err != nil {
+		return fmt.Errorf("failed to delete view: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrViewNotFound
+	}
+
+	return nil
+}
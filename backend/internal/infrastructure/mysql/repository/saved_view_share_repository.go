@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type savedViewShareRepository struct{}
+
+// NewSavedViewShareRepositoryは新しいSavedViewShareRepository実装を作成する
+func NewSavedViewShareRepository() domain.SavedViewShareRepository {
+	return &savedViewShareRepository{}
+}
+
+// Upsertはビューの共有を作成する（共有済みの場合は共有者を更新する）
+func (r *savedViewShareRepository) Upsert(ctx context.Context, ex domain.Executor, share *domain.SavedViewShare) error {
+	m := model.SavedViewShareFromDomain(share)
+
+	query := `
+		INSERT INTO saved_view_shares (view_id, user_id, shared_by, created_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE shared_by = VALUES(shared_by)
+	`
+
+	_, err := ex.ExecContext(ctx, query, m.ViewID, m.UserID, m.SharedBy, m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert view share: %w", err)
+	}
+
+	return nil
+}
+
+// Deleteはビューの共有を削除する
+func (r *savedViewShareRepository) Delete(ctx context.Context, ex domain.Executor, viewID, userID int64) error {
+	query := `
+		DELETE FROM saved_view_shares
+		WHERE view_id = ? AND user_id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, viewID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete view share: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrViewShareNotFound
+	}
+
+	return nil
+}
+
+// FindByViewIDはビューの共有一覧を取得する
+func (r *savedViewShareRepository) FindByViewID(ctx context.Context, ex domain.Executor, viewID int64) ([]*domain.SavedViewShare, error) {
+	query := `
+		SELECT view_id, user_id, shared_by, created_at
+		FROM saved_view_shares
+		WHERE view_id = ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, viewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find view shares: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var shares []*domain.SavedViewShare
+	for rows.Next() {
+		var m model.SavedViewShare
+		if err := rows.Scan(&m.ViewID, &m.UserID, &m.SharedBy, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan view share: %w", err)
+		}
+		shares = append(shares, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating view shares: %w", err)
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type sessionRepository struct{}
+
+// NewSessionRepository は新しい SessionRepository 実装を作成します
+func NewSessionRepository() domain.SessionRepository {
+	return &sessionRepository{}
+}
+
+// sessionColumns はセッション取得時のSELECT列（scanSessionの順序と一致させる）
+const sessionColumns = `id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at`
+
+// scanSession はsessionColumnsの順序で1行を読み込みます
+func scanSession(row domain.Row) (*model.Session, error) {
+	var m model.Session
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.UserAgent,
+		&m.IPAddress,
+		&m.CreatedAt,
+		&m.LastUsedAt,
+		&m.ExpiresAt,
+		&m.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Create はセッションを保存します
+func (r *sessionRepository) Create(ctx context.Context, ex domain.Executor, session *domain.Session) error {
+	m := model.SessionFromDomain(session)
+
+	query := `
+		INSERT INTO sessions (user_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query, m.UserID, m.UserAgent, m.IPAddress, m.CreatedAt, m.LastUsedAt, m.ExpiresAt, m.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	session.ID = id
+
+	return nil
+}
+
+// FindByID はIDでセッションを取得します
+func (r *sessionRepository) FindByID(ctx context.Context, ex domain.Executor, sessionID int64) (*domain.Session, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM sessions
+		WHERE id = ?
+	`
+
+	m, err := scanSession(ex.QueryRowContext(ctx, query, sessionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+// FindActiveByUserID はユーザーの有効なセッションを最終利用日時の新しい順に取得します
+func (r *sessionRepository) FindActiveByUserID(ctx context.Context, ex domain.Executor, userID int64, now time.Time) ([]*domain.Session, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM sessions
+		WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ?
+		ORDER BY last_used_at DESC, id DESC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		m, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// Update はセッションの最終利用日時・接続元・有効期限を更新します
+func (r *sessionRepository) Update(ctx context.Context, ex domain.Executor, session *domain.Session) error {
+	m := model.SessionFromDomain(session)
+
+	query := `
+		UPDATE sessions
+		SET user_agent = ?, ip_address = ?, last_used_at = ?, expires_at = ?
+		WHERE id = ?
+	`
+
+	_, err := ex.ExecContext(ctx, query, m.UserAgent, m.IPAddress, m.LastUsedAt, m.ExpiresAt, m.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return nil
+}
+
+// Revoke はセッションを無効にします（既に無効な場合は何もしません）
+func (r *sessionRepository) Revoke(ctx context.Context, ex domain.Executor, sessionID int64, now time.Time) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = ?
+		WHERE id = ? AND revoked_at IS NULL
+	`
+
+	_, err := ex.ExecContext(ctx, query, now, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeByUserID はユーザーの有効なセッションをexceptSessionID以外すべて無効にし、無効にした件数を返します
+// exceptSessionIDに0を指定した場合はすべてのセッションを無効にします
+func (r *sessionRepository) RevokeByUserID(ctx context.Context, ex domain.Executor, userID, exceptSessionID int64, now time.Time) (int64, error) {
+	query := `
+		UPDATE sessions
+		SET revoked_at = ?
+		WHERE user_id = ? AND id <> ? AND revoked_at IS NULL AND expires_at > ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, now, userID, exceptSessionID, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// DeleteExpired は有効期限が切れたセッションを削除し、削除した件数を返します（リフレッシュトークンも削除されます）
+func (r *sessionRepository) DeleteExpired(ctx context.Context, ex domain.Executor, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM sessions
+		WHERE expires_at <= ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type slaPolicyRepository struct{}
+
+// NewSLAPolicyRepository は新しい SLAPolicyRepository 実装を作成します
+func NewSLAPolicyRepository() domain.SLAPolicyRepository {
+	return &slaPolicyRepository{}
+}
+
+// FindAll はすべてのSLAポリシーを優先度の高い順に取得します
+func (r *slaPolicyRepository) FindAll(ctx context.Context, ex domain.Executor) ([]*domain.SLAPolicy, error) {
+	query := `
+		SELECT priority, start_within_minutes, resolve_within_minutes, escalate_before_minutes, escalation_action
+		FROM sla_policies
+		ORDER BY priority DESC
+	`
+
+	rows, err := ex.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sla policies: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var policies []*domain.SLAPolicy
+	for rows.Next() {
+		var m model.SLAPolicy
+		err := rows.Scan(
+			&m.Priority,
+			&m.StartWithinMinutes,
+			&m.ResolveWithinMinutes,
+			&m.EscalateBeforeMinutes,
+			&m.EscalationAction,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sla policy: %w", err)
+		}
+		policies = append(policies, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sla policies: %w", err)
+	}
+
+	return policies, nil
+}
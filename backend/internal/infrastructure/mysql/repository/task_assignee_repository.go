@@ -3,7 +3,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/ryusuke/task_app_layerx/internal/domain"
 	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
@@ -33,7 +32,7 @@ func (r *taskAssigneeRepository) Create(ctx context.Context, ex domain.Executor,
 	)
 	if err != nil {
 		// MySQL の Duplicate entry エラー（Error 1062）を検出
-		if strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "Error 1062") {
+		if isDuplicateEntryError(err) {
 			return domain.ErrDuplicateAssignee
 		}
 		return fmt.Errorf("failed to create task assignee: %w", err)
@@ -42,6 +41,29 @@ func (r *taskAssigneeRepository) Create(ctx context.Context, ex domain.Executor,
 	return nil
 }
 
+// Delete は指定されたタスクから担当者を外します
+func (r *taskAssigneeRepository) Delete(ctx context.Context, ex domain.Executor, taskID, userID int64) error {
+	query := `
+		DELETE FROM task_assignees
+		WHERE task_id = ? AND user_id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task assignee: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrAssigneeNotFound
+	}
+
+	return nil
+}
+
 // FindByTaskID は指定されたタスクのすべての担当者を取得します
 func (r *taskAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskAssignee, error) {
 	query := `
@@ -87,7 +109,7 @@ func (r *taskAssigneeRepository) DeleteByTaskID(ctx context.Context, ex domain.E
 		DELETE FROM task_assignees
 		WHERE task_id = ?
 	`
-	
+
 	_, err := ex.ExecContext(ctx, query, taskID)
 	if err != nil {
 		return fmt.Errorf("failed to delete task assignees: %w", err)
@@ -95,3 +117,56 @@ func (r *taskAssigneeRepository) DeleteByTaskID(ctx context.Context, ex domain.E
 
 	return nil
 }
+
+// WorkloadByUserID は閲覧者が閲覧可能なタスクのうち条件に一致するものを、直接アサインされたユーザー・優先度ごとに集計します
+// 結果はユーザーID順で、条件に一致するタスクがアサインされていないユーザーは含みません
+func (r *taskAssigneeRepository) WorkloadByUserID(ctx context.Context, ex domain.Executor, viewerID int64, filter domain.TaskFilter) ([]*domain.AssigneeWorkload, error) {
+	condition, conditionArgs := taskFilterCondition(viewerID, filter)
+	now, today := filter.OverdueBounds()
+	query := `
+		SELECT users.id, users.name, users.email, tasks.priority, COUNT(*), COALESCE(SUM(` + overdueTaskCondition + `), 0)
+		FROM task_assignees
+		JOIN tasks ON tasks.id = task_assignees.task_id
+		JOIN users ON users.id = task_assignees.user_id
+		WHERE tasks.deleted_at IS NULL
+		  AND ` + visibleTaskCondition + condition + `
+		GROUP BY users.id, users.name, users.email, tasks.priority
+		ORDER BY users.id, tasks.priority
+	`
+
+	args := append([]any{today, now}, visibleTaskArgs(viewerID)...)
+	args = append(args, conditionArgs...)
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate workload: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var workloads []*domain.AssigneeWorkload
+	for rows.Next() {
+		var (
+			userID          int64
+			name, email     string
+			priority, count int
+			overdue         int
+		)
+		if err := rows.Scan(&userID, &name, &email, &priority, &count, &overdue); err != nil {
+			return nil, fmt.Errorf("failed to scan workload: %w", err)
+		}
+		if len(workloads) == 0 || workloads[len(workloads)-1].UserID != userID {
+			workloads = append(workloads, domain.NewAssigneeWorkload(userID, name, email))
+		}
+		workload := workloads[len(workloads)-1]
+		workload.Total += count
+		workload.ByPriority[priority] += count
+		workload.Overdue += overdue
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workload: %w", err)
+	}
+
+	return workloads, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type taskDependencyRepository struct{}
+
+// NewTaskDependencyRepository は新しい TaskDependencyRepository 実装を作成します
+func NewTaskDependencyRepository() domain.TaskDependencyRepository {
+	return &taskDependencyRepository{}
+}
+
+// Create はタスク間の依存関係を作成します
+func (r *taskDependencyRepository) Create(ctx context.Context, ex domain.Executor, dependency *domain.TaskDependency) error {
+	m := model.TaskDependencyFromDomain(dependency)
+
+	query := `
+		INSERT INTO task_dependencies (task_id, depends_on_task_id, created_by, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	_, err := ex.ExecContext(ctx, query,
+		m.TaskID,
+		m.DependsOnTaskID,
+		m.CreatedBy,
+		m.CreatedAt,
+	)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrDuplicateDependency
+		}
+		return fmt.Errorf("failed to create task dependency: %w", err)
+	}
+
+	return nil
+}
+
+// Delete はタスク間の依存関係を削除します
+func (r *taskDependencyRepository) Delete(ctx context.Context, ex domain.Executor, taskID, dependsOnTaskID int64) error {
+	query := `
+		DELETE FROM task_dependencies
+		WHERE task_id = ? AND depends_on_task_id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, taskID, dependsOnTaskID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task dependency: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrDependencyNotFound
+	}
+
+	return nil
+}
+
+// FindByTaskID は指定されたタスクが依存しているすべての依存関係を取得します
+func (r *taskDependencyRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskDependency, error) {
+	return r.FindByTaskIDs(ctx, ex, []int64{taskID})
+}
+
+// FindByTaskIDs は指定されたタスク群が依存しているすべての依存関係を取得します
+func (r *taskDependencyRepository) FindByTaskIDs(ctx context.Context, ex domain.Executor, taskIDs []int64) ([]*domain.TaskDependency, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(taskIDs)), ", ")
+	query := `
+		SELECT task_dependencies.task_id, task_dependencies.depends_on_task_id, task_dependencies.created_by, task_dependencies.created_at
+		FROM task_dependencies
+		JOIN tasks ON tasks.id = task_dependencies.depends_on_task_id
+		WHERE task_dependencies.task_id IN (` + placeholders + `)
+		  AND tasks.deleted_at IS NULL
+		ORDER BY task_dependencies.task_id ASC, task_dependencies.created_at ASC
+	`
+
+	args := make([]any, len(taskIDs))
+	for i, id := range taskIDs {
+		args[i] = id
+	}
+
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task dependencies: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var dependencies []*domain.TaskDependency
+	for rows.Next() {
+		var m model.TaskDependency
+		err := rows.Scan(
+			&m.TaskID,
+			&m.DependsOnTaskID,
+			&m.CreatedBy,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task dependency: %w", err)
+		}
+		dependencies = append(dependencies, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task dependencies: %w", err)
+	}
+
+	return dependencies, nil
+}
+
+// DeleteByTaskID は指定されたタスクが関わるすべての依存関係（依存元・依存先の両方）を削除します
+func (r *taskDependencyRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	query := `
+		DELETE FROM task_dependencies
+		WHERE task_id = ? OR depends_on_task_id = ?
+	`
+
+	_, err := ex.ExecContext(ctx, query, taskID, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task dependencies: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type taskGroupAssigneeRepository struct{}
+
+// NewTaskGroupAssigneeRepository は新しい TaskGroupAssigneeRepository 実装を作成します
+func NewTaskGroupAssigneeRepository() domain.TaskGroupAssigneeRepository {
+	return &taskGroupAssigneeRepository{}
+}
+
+// Create はタスクにグループをアサインします
+func (r *taskGroupAssigneeRepository) Create(ctx context.Context, ex domain.Executor, assignee *domain.TaskGroupAssignee) error {
+	m := model.TaskGroupAssigneeFromDomain(assignee)
+
+	query := `
+		INSERT INTO task_group_assignees (task_id, group_id, assigned_by, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	_, err := ex.ExecContext(ctx, query,
+		m.TaskID,
+		m.GroupID,
+		m.AssignedBy,
+		m.CreatedAt,
+	)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return domain.ErrDuplicateGroupAssignee
+		}
+		return fmt.Errorf("failed to create task group assignee: %w", err)
+	}
+
+	return nil
+}
+
+// FindByTaskID は指定されたタスクにアサインされたすべてのグループを取得します
+func (r *taskGroupAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskGroupAssignee, error) {
+	query := `
+		SELECT task_id, group_id, assigned_by, created_at
+		FROM task_group_assignees
+		WHERE task_id = ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task group assignees: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var assignees []*domain.TaskGroupAssignee
+	for rows.Next() {
+		var m model.TaskGroupAssignee
+		err := rows.Scan(
+			&m.TaskID,
+			&m.GroupID,
+			&m.AssignedBy,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task group assignee: %w", err)
+		}
+		assignees = append(assignees, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task group assignees: %w", err)
+	}
+
+	return assignees, nil
+}
+
+// DeleteByTaskID は指定されたタスクのすべてのグループアサインを削除します
+func (r *taskGroupAssigneeRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	query := `
+		DELETE FROM task_group_assignees
+		WHERE task_id = ?
+	`
+
+	_, err := ex.ExecContext(ctx, query, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task group assignees: %w", err)
+	}
+
+	return nil
+}
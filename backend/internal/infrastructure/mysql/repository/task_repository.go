@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ryusuke/task_app_layerx/internal/domain"
@@ -13,6 +14,92 @@ import (
 
 type taskRepository struct{}
 
+// visibleTaskConditionはユーザーが閲覧可能なタスク（オーナー・直接アサイン・グループアサイン・共有）に絞り込む条件
+// プレースホルダーにはvisibleTaskArgsの戻り値を渡す
+const visibleTaskCondition = `(
+		    tasks.owner_id = ?
+		    OR EXISTS (
+		      SELECT 1
+		      FROM task_assignees
+		      WHERE task_assignees.task_id = tasks.id
+		        AND task_assignees.user_id = ?
+		    )
+		    OR EXISTS (
+		      SELECT 1
+		      FROM task_group_assignees
+		      JOIN group_members ON group_members.group_id = task_group_assignees.group_id
+		      WHERE task_group_assignees.task_id = tasks.id
+		        AND group_members.user_id = ?
+		    )
+		    OR EXISTS (
+		      SELECT 1
+		      FROM task_shares
+		      WHERE task_shares.task_id = tasks.id
+		        AND task_shares.user_id = ?
+		    )
+		  )`
+
+// assignedTaskConditionはユーザーが直接またはグループでアサインされているタスクに絞り込む条件
+// プレースホルダーにはユーザーIDを2つ渡す
+const assignedTaskCondition = `(
+		    EXISTS (
+		      SELECT 1
+		      FROM task_assignees
+		      WHERE task_assignees.task_id = tasks.id
+		        AND task_assignees.user_id = ?
+		    )
+		    OR EXISTS (
+		      SELECT 1
+		      FROM task_group_assignees
+		      JOIN group_members ON group_members.group_id = task_group_assignees.group_id
+		      WHERE task_group_assignees.task_id = tasks.id
+		        AND group_members.user_id = ?
+		    )
+		  )`
+
+// overdueTaskConditionは期限切れのタスクに絞り込む条件（Task.IsOverdueと同じ判定）
+// プレースホルダーには終日の期日の基準日（today）と時刻付きの期日の基準時刻（now）を渡す
+const overdueTaskCondition = `(tasks.status <> 'DONE' AND tasks.due_date IS NOT NULL AND (
+		    (tasks.due_all_day = 1 AND tasks.due_date < ?)
+		    OR (tasks.due_all_day = 0 AND tasks.due_date <= ?)
+		  ))`
+
+// visibleTaskArgsはvisibleTaskConditionのプレースホルダーに渡す引数を返す
+func visibleTaskArgs(userID int64) []any {
+	return []any{userID, userID, userID, userID}
+}
+
+// taskColumnsはタスク取得時のSELECT列（scanTaskの順序と一致させる）
+const taskColumns = `tasks.id, tasks.owner_id, tasks.title, tasks.description, tasks.start_date, tasks.due_date, tasks.due_all_day,
+		tasks.status, tasks.priority, tasks.started_at, tasks.completed_at, tasks.sla_escalated_at, tasks.version, tasks.created_at, tasks.updated_at, tasks.deleted_at`
+
+// scanTaskはtaskColumnsの順序で1行を読み込む
+func scanTask(row domain.Row) (*model.Task, error) {
+	var m model.Task
+	err := row.Scan(
+		&m.ID,
+		&m.OwnerID,
+		&m.Title,
+		&m.Description,
+		&m.StartDate,
+		&m.DueDate,
+		&m.DueAllDay,
+		&m.Status,
+		&m.Priority,
+		&m.StartedAt,
+		&m.CompletedAt,
+		&m.SLAEscalatedAt,
+		&m.Version,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
 // NewTaskRepositoryは新しいTaskRepository実装を作成する
 func NewTaskRepository() domain.TaskRepository {
 	return &taskRepository{}
@@ -23,17 +110,24 @@ func (r *taskRepository) Create(ctx context.Context, ex domain.Executor, task *d
 	m := model.TaskFromDomain(task)
 
 	query := `
-		INSERT INTO tasks (owner_id, title, description, due_date, status, priority, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO tasks (owner_id, title, description, start_date, due_date, due_all_day, status, priority,
+			started_at, completed_at, sla_escalated_at, version, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	result, err := ex.ExecContext(ctx, query,
 		m.OwnerID,
 		m.Title,
 		m.Description,
+		m.StartDate,
 		m.DueDate,
+		m.DueAllDay,
 		m.Status,
 		m.Priority,
+		m.StartedAt,
+		m.CompletedAt,
+		m.SLAEscalatedAt,
+		m.Version,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -52,27 +146,23 @@ func (r *taskRepository) Create(ctx context.Context, ex domain.Executor, task *d
 
 // FindByIDはIDでタスクを取得する
 func (r *taskRepository) FindByID(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	return r.findByID(ctx, ex, taskID, "")
+}
+
+// FindByIDForUpdateはタスクの行をロックして取得する（トランザクション内で使う）
+// 読み込んだバージョンで更新・削除するため、読み込みから保存までの間に他の更新が入らないようにする
+func (r *taskRepository) FindByIDForUpdate(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	return r.findByID(ctx, ex, taskID, " FOR UPDATE")
+}
+
+// findByIDはIDでタスクを取得する（lockにはロックの句を指定する）
+func (r *taskRepository) findByID(ctx context.Context, ex domain.Executor, taskID int64, lock string) (*domain.Task, error) {
 	query := `
-		SELECT id, owner_id, title, description, due_date, status, priority, created_at, updated_at, deleted_at
+		SELECT ` + taskColumns + `
 		FROM tasks
-		WHERE id = ? AND deleted_at IS NULL
-	`
-
-	row := ex.QueryRowContext(ctx, query, taskID)
+		WHERE id = ? AND deleted_at IS NULL` + lock
 
-	var m model.Task
-	err := row.Scan(
-		&m.ID,
-		&m.OwnerID,
-		&m.Title,
-		&m.Description,
-		&m.DueDate,
-		&m.Status,
-		&m.Priority,
-		&m.CreatedAt,
-		&m.UpdatedAt,
-		&m.DeletedAt,
-	)
+	m, err := scanTask(ex.QueryRowContext(ctx, query, taskID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTaskNotFound
@@ -83,36 +173,231 @@ func (r *taskRepository) FindByID(ctx context.Context, ex domain.Executor, taskI
 	return m.ToDomain(), nil
 }
 
-// ListByUserIDはユーザーが所有または割り当てられているタスクを取得する
-func (r *taskRepository) ListByUserID(ctx context.Context, ex domain.Executor, userID int64, limit, offset int) ([]*domain.Task, error) {
-	// デフォルト値とバリデーション
-	if limit <= 0 || limit > 100 {
-		limit = 100
+// ListByUserIDはユーザーが閲覧可能なタスク（所有・アサイン・グループアサイン・共有）を絞り込み条件と並び順に従って取得する
+// afterを指定した場合はそのカーソルの位置より後のタスクを取得する（キーセットページング）
+// filterはValidate済みであること
+func (r *taskRepository) ListByUserID(ctx context.Context, ex domain.Executor, userID int64, filter domain.TaskFilter, after *domain.TaskCursor, limit int) ([]*domain.Task, error) {
+	// デフォルト値とバリデーション（次のページの有無を判定できるよう、上限より1件多く取得できる）
+	if limit <= 0 || limit > 101 {
+		limit = 101
+	}
+
+	condition, conditionArgs := taskFilterCondition(userID, filter)
+	if after != nil {
+		cursorCondition, cursorArgs := taskCursorCondition(filter, after)
+		condition += cursorCondition
+		conditionArgs = append(conditionArgs, cursorArgs...)
+	}
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND ` + visibleTaskCondition + condition + `
+		ORDER BY ` + taskOrderBy(filter) + `
+		LIMIT ?
+	`
+
+	args := append(visibleTaskArgs(userID), conditionArgs...)
+	args = append(args, limit)
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
 	}
-	if offset < 0 {
-		offset = 0
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		m, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tasks: %w", err)
 	}
 
+	return tasks, nil
+}
+
+// CountByUserIDはListByUserIDと同じ条件のタスクの件数を取得する
+func (r *taskRepository) CountByUserID(ctx context.Context, ex domain.Executor, userID int64, filter domain.TaskFilter) (int, error) {
+	condition, conditionArgs := taskFilterCondition(userID, filter)
 	query := `
-		SELECT id, owner_id, title, description, due_date, status, priority, created_at, updated_at, deleted_at
+		SELECT COUNT(*)
 		FROM tasks
 		WHERE deleted_at IS NULL
+		  AND ` + visibleTaskCondition + condition + `
+	`
+
+	var count int
+	args := append(visibleTaskArgs(userID), conditionArgs...)
+	if err := ex.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	return count, nil
+}
+
+// taskFilterConditionは絞り込み条件を" AND ..."の形のSQLとプレースホルダーの引数に変換する
+func taskFilterCondition(userID int64, filter domain.TaskFilter) (string, []any) {
+	var b strings.Builder
+	var args []any
+
+	if len(filter.Statuses) > 0 {
+		b.WriteString(" AND tasks.status IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(filter.Statuses)), ", ") + ")")
+		for _, status := range filter.Statuses {
+			args = append(args, string(status))
+		}
+	}
+	if filter.PriorityMin != nil {
+		b.WriteString(" AND tasks.priority >= ?")
+		args = append(args, *filter.PriorityMin)
+	}
+	if filter.PriorityMax != nil {
+		b.WriteString(" AND tasks.priority <= ?")
+		args = append(args, *filter.PriorityMax)
+	}
+
+	ranges := []struct {
+		column   string
+		from, to *time.Time
+	}{
+		{"tasks.due_date", filter.DueFrom, filter.DueTo},
+		{"tasks.created_at", filter.CreatedFrom, filter.CreatedTo},
+		{"tasks.updated_at", filter.UpdatedFrom, filter.UpdatedTo},
+	}
+	for _, rg := range ranges {
+		if rg.from != nil {
+			b.WriteString(" AND " + rg.column + " >= ?")
+			args = append(args, rg.from.UTC())
+		}
+		if rg.to != nil {
+			b.WriteString(" AND " + rg.column + " < ?")
+			args = append(args, rg.to.UTC())
+		}
+	}
+
+	if filter.Overdue != nil {
+		// Task.IsOverdueと同じく、終日の期日はユーザーのタイムゾーンでその日が終わったら期限切れとする
+		now, today := filter.OverdueBounds()
+		if *filter.Overdue {
+			b.WriteString(" AND " + overdueTaskCondition)
+		} else {
+			b.WriteString(" AND NOT " + overdueTaskCondition)
+		}
+		args = append(args, today, now)
+	}
+
+	switch filter.Role {
+	case domain.TaskRoleOwner:
+		b.WriteString(" AND tasks.owner_id = ?")
+		args = append(args, userID)
+	case domain.TaskRoleAssigned:
+		b.WriteString(" AND " + assignedTaskCondition)
+		args = append(args, userID, userID)
+	}
+
+	if filter.AssigneeID != nil {
+		b.WriteString(` AND EXISTS (
+			      SELECT 1
+			      FROM task_assignees
+			      WHERE task_assignees.task_id = tasks.id
+			        AND task_assignees.user_id = ?
+			    )`)
+		args = append(args, *filter.AssigneeID)
+	}
+
+	return b.String(), args
+}
+
+// taskCursorConditionはtaskOrderByの並び順でカーソルより後の行に絞り込む" AND ..."の形のSQLと引数を返す
+func taskCursorCondition(filter domain.TaskFilter, after *domain.TaskCursor) (string, []any) {
+	op := "<"
+	if filter.SortDirection == domain.SortAsc {
+		op = ">"
+	}
+
+	switch filter.SortKey {
+	case domain.TaskSortPriority:
+		return " AND (tasks.priority " + op + " ? OR (tasks.priority = ? AND tasks.id " + op + " ?))",
+			[]any{after.Priority, after.Priority, after.ID}
+	case domain.TaskSortDueDate:
+		// 期日なしは常に末尾に並ぶ
+		if after.Time == nil {
+			return " AND tasks.due_date IS NULL AND tasks.id " + op + " ?", []any{after.ID}
+		}
+		due := after.Time.UTC()
+		return " AND (tasks.due_date IS NULL OR tasks.due_date " + op + " ? OR (tasks.due_date = ? AND tasks.id " + op + " ?))",
+			[]any{due, due, after.ID}
+	}
+
+	column, ok := taskSortColumns[filter.SortKey]
+	if !ok {
+		column = taskSortColumns[domain.TaskSortCreatedAt]
+	}
+	value := after.Time.UTC()
+	return " AND (" + column + " " + op + " ? OR (" + column + " = ? AND tasks.id " + op + " ?))",
+		[]any{value, value, after.ID}
+}
+
+// taskSortColumnsは並び替えに使える項目と列の対応（ORDER BYに埋め込むためホワイトリストとする）
+var taskSortColumns = map[domain.TaskSortKey]string{
+	domain.TaskSortCreatedAt: "tasks.created_at",
+	domain.TaskSortDueDate:   "tasks.due_date",
+	domain.TaskSortPriority:  "tasks.priority",
+	domain.TaskSortUpdatedAt: "tasks.updated_at",
+}
+
+// taskOrderByは並び順をORDER BY句に変換する（同順位はIDで並べ、期日なしは常に末尾にする）
+func taskOrderBy(filter domain.TaskFilter) string {
+	column, ok := taskSortColumns[filter.SortKey]
+	if !ok {
+		column = taskSortColumns[domain.TaskSortCreatedAt]
+	}
+	direction := "DESC"
+	if filter.SortDirection == domain.SortAsc {
+		direction = "ASC"
+	}
+
+	orderBy := column + " " + direction + ", tasks.id " + direction
+	if filter.SortKey == domain.TaskSortDueDate {
+		orderBy = "tasks.due_date IS NULL, " + orderBy
+	}
+	return orderBy
+}
+
+// ListScheduledByUserIDはユーザーが閲覧可能なタスクのうち、期間と日程が重なるものを取得する
+// 終日の期日はScheduleRange.AllDayBoundsで求めた日付と比較する（Task.DueDeadlineと同じく閲覧者のタイムゾーンで判定）
+func (r *taskRepository) ListScheduledByUserID(ctx context.Context, ex domain.Executor, userID int64, scheduleRange domain.ScheduleRange) ([]*domain.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND (start_date IS NOT NULL OR due_date IS NOT NULL)
 		  AND (
-		    owner_id = ?
-		    OR EXISTS (
-		      SELECT 1
-		      FROM task_assignees
-		      WHERE task_assignees.task_id = tasks.id
-		        AND task_assignees.user_id = ?
-		    )
+		    (start_date IS NOT NULL AND start_date < ?)
+		    OR (start_date IS NULL AND due_all_day = 0 AND due_date < ?)
+		    OR (start_date IS NULL AND due_all_day = 1 AND due_date < ?)
 		  )
-		ORDER BY created_at DESC
-		LIMIT ? OFFSET ?
+		  AND (
+		    (due_date IS NOT NULL AND due_all_day = 0 AND due_date >= ?)
+		    OR (due_date IS NOT NULL AND due_all_day = 1 AND due_date >= ?)
+		    OR (due_date IS NULL AND start_date >= ?)
+		  )
+		  AND ` + visibleTaskCondition + `
+		ORDER BY COALESCE(start_date, due_date) ASC, id ASC
 	`
 
-	rows, err := ex.QueryContext(ctx, query, userID, userID, limit, offset)
+	from, to := scheduleRange.From.UTC(), scheduleRange.To.UTC()
+	fromDay, toDay := scheduleRange.AllDayBounds()
+	args := append([]any{to, to, toDay, from, fromDay, from}, visibleTaskArgs(userID)...)
+	rows, err := ex.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tasks: %w", err)
+		return nil, fmt.Errorf("failed to list scheduled tasks: %w", err)
 	}
 	defer func() {
 		_ = rows.Close()
@@ -120,19 +405,7 @@ func (r *taskRepository) ListByUserID(ctx context.Context, ex domain.Executor, u
 
 	var tasks []*domain.Task
 	for rows.Next() {
-		var m model.Task
-		err := rows.Scan(
-			&m.ID,
-			&m.OwnerID,
-			&m.Title,
-			&m.Description,
-			&m.DueDate,
-			&m.Status,
-			&m.Priority,
-			&m.CreatedAt,
-			&m.UpdatedAt,
-			&m.DeletedAt,
-		)
+		m, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -140,30 +413,196 @@ func (r *taskRepository) ListByUserID(ctx context.Context, ex domain.Executor, u
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating tasks: %w", err)
+		return nil, fmt.Errorf("error iterating scheduled tasks: %w", err)
 	}
 
 	return tasks, nil
 }
 
-// Updateは既存のタスクを更新する
+// ListUnescalatedOpenは指定した優先度の未完了かつ未エスカレーションのタスクを取得する（SLAエスカレーション用）
+func (r *taskRepository) ListUnescalatedOpen(ctx context.Context, ex domain.Executor, priorities []int) ([]*domain.Task, error) {
+	if len(priorities) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(priorities)), ", ")
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND status <> 'DONE'
+		  AND sla_escalated_at IS NULL
+		  AND priority IN (` + placeholders + `)
+		ORDER BY created_at ASC
+	`
+
+	args := make([]any, len(priorities))
+	for i, priority := range priorities {
+		args[i] = priority
+	}
+
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unescalated tasks: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		m, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating unescalated tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// FindVisibleIDsは指定したタスクIDのうちユーザーが閲覧可能なもののIDを取得する（タスク参照のリンク判定用）
+func (r *taskRepository) FindVisibleIDs(ctx context.Context, ex domain.Executor, userID int64, taskIDs []int64) ([]int64, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(taskIDs)), ", ")
+	query := `
+		SELECT tasks.id
+		FROM tasks
+		WHERE tasks.deleted_at IS NULL
+		  AND tasks.id IN (` + placeholders + `)
+		  AND ` + visibleTaskCondition + `
+	`
+
+	args := make([]any, 0, len(taskIDs)+4)
+	for _, taskID := range taskIDs {
+		args = append(args, taskID)
+	}
+	args = append(args, visibleTaskArgs(userID)...)
+
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find visible task ids: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan task id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating visible task ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// StatsByUserIDはユーザーが閲覧可能なタスクをSQLで集計する
+func (r *taskRepository) StatsByUserID(ctx context.Context, ex domain.Executor, userID int64, bounds domain.TaskStatsBounds) (*domain.TaskStats, error) {
+	stats := domain.NewTaskStats()
+
+	summaryQuery := `
+		SELECT
+		  COUNT(*),
+		  COALESCE(SUM(` + overdueTaskCondition + `), 0),
+		  COALESCE(SUM(tasks.status <> 'DONE' AND tasks.due_date IS NOT NULL AND (
+		    (tasks.due_all_day = 1 AND tasks.due_date >= ? AND tasks.due_date < ?)
+		    OR (tasks.due_all_day = 0 AND tasks.due_date >= ? AND tasks.due_date < ?)
+		  )), 0),
+		  COALESCE(SUM(tasks.owner_id = ?), 0),
+		  COALESCE(SUM(` + assignedTaskCondition + `), 0)
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND ` + visibleTaskCondition + `
+	`
+	args := []any{
+		bounds.Today, bounds.Now,
+		bounds.WeekStartDate, bounds.WeekEndDate, bounds.WeekStart, bounds.WeekEnd,
+		userID,
+		userID, userID,
+	}
+	args = append(args, visibleTaskArgs(userID)...)
+	if err := ex.QueryRowContext(ctx, summaryQuery, args...).Scan(
+		&stats.Total,
+		&stats.Overdue,
+		&stats.DueThisWeek,
+		&stats.Owned,
+		&stats.Assigned,
+	); err != nil {
+		return nil, fmt.Errorf("failed to aggregate tasks: %w", err)
+	}
+
+	groupQuery := `
+		SELECT tasks.status, tasks.priority, COUNT(*)
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND ` + visibleTaskCondition + `
+		GROUP BY tasks.status, tasks.priority
+	`
+	rows, err := ex.QueryContext(ctx, groupQuery, visibleTaskArgs(userID)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tasks by status and priority: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var status string
+		var priority, count int
+		if err := rows.Scan(&status, &priority, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan task counts: %w", err)
+		}
+		stats.ByStatus[domain.TaskStatus(status)] += count
+		stats.ByPriority[priority] += count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task counts: %w", err)
+	}
+
+	return stats, nil
+}
+
+// Updateは既存のタスクを更新し、バージョンを1つ進める
+// task.Versionが保存されているバージョンと一致しない場合はErrTaskVersionMismatchを返す
 func (r *taskRepository) Update(ctx context.Context, ex domain.Executor, task *domain.Task) error {
 	m := model.TaskFromDomain(task)
 
 	query := `
 		UPDATE tasks
-		SET title = ?, description = ?, due_date = ?, status = ?, priority = ?, updated_at = ?
-		WHERE id = ? AND deleted_at IS NULL
+		SET title = ?, description = ?, start_date = ?, due_date = ?, due_all_day = ?, status = ?, priority = ?,
+			started_at = ?, completed_at = ?, sla_escalated_at = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ? AND deleted_at IS NULL
 	`
 
 	result, err := ex.ExecContext(ctx, query,
 		m.Title,
 		m.Description,
+		m.StartDate,
 		m.DueDate,
+		m.DueAllDay,
 		m.Status,
 		m.Priority,
+		m.StartedAt,
+		m.CompletedAt,
+		m.SLAEscalatedAt,
 		m.UpdatedAt,
 		m.ID,
+		m.Version,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
@@ -174,21 +613,23 @@ func (r *taskRepository) Update(ctx context.Context, ex domain.Executor, task *d
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if affected == 0 {
-		return domain.ErrTaskNotFound
+		return r.notUpdatedError(ctx, ex, task.ID)
 	}
 
+	task.Version++
 	return nil
 }
 
 // Deleteはタスクの論理削除を実行する
-func (r *taskRepository) Delete(ctx context.Context, ex domain.Executor, taskID int64, now time.Time) error {
+// versionが保存されているバージョンと一致しない場合はErrTaskVersionMismatchを返す
+func (r *taskRepository) Delete(ctx context.Context, ex domain.Executor, taskID, version int64, now time.Time) error {
 	query := `
 		UPDATE tasks
-		SET deleted_at = ?, updated_at = ?
-		WHERE id = ? AND deleted_at IS NULL
+		SET deleted_at = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ? AND deleted_at IS NULL
 	`
 
-	result, err := ex.ExecContext(ctx, query, now, now, taskID)
+	result, err := ex.ExecContext(ctx, query, now, now, taskID, version)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
@@ -198,8 +639,22 @@ func (r *taskRepository) Delete(ctx context.Context, ex domain.Executor, taskID
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if affected == 0 {
-		return domain.ErrTaskNotFound
+		return r.notUpdatedError(ctx, ex, taskID)
 	}
 
 	return nil
 }
+
+// notUpdatedErrorはバージョン付きの更新で対象の行がなかった理由を判定する
+// タスクが存在する場合はバージョンの不一致（他の更新が先に行われた）とみなす
+func (r *taskRepository) notUpdatedError(ctx context.Context, ex domain.Executor, taskID int64) error {
+	var exists int
+	err := ex.QueryRowContext(ctx, `SELECT 1 FROM tasks WHERE id = ? AND deleted_at IS NULL`, taskID).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrTaskNotFound
+		}
+		return fmt.Errorf("failed to check task existence: %w", err)
+	}
+	return domain.ErrTaskVersionMismatch
+}
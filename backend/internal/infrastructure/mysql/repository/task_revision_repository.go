@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type taskRevisionRepository struct{}
+
+// taskRevisionColumnsはリビジョン取得時のSELECT列（scanTaskRevisionの順序と一致させる）
+const taskRevisionColumns = `task_id, revision, title, description, start_date, due_date, due_all_day, status, priority,
+		assignee_ids, assignee_group_ids, changed_by, created_at`
+
+// scanTaskRevisionはtaskRevisionColumnsの順序で1行を読み込む
+func scanTaskRevision(row domain.Row) (*domain.TaskRevision, error) {
+	var m model.TaskRevision
+	err := row.Scan(
+		&m.TaskID,
+		&m.Revision,
+		&m.Title,
+		&m.Description,
+		&m.StartDate,
+		&m.DueDate,
+		&m.DueAllDay,
+		&m.Status,
+		&m.Priority,
+		&m.AssigneeIDs,
+		&m.AssigneeGroupIDs,
+		&m.ChangedBy,
+		&m.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := m.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode task revision: %w", err)
+	}
+	return revision, nil
+}
+
+// NewTaskRevisionRepositoryは新しいTaskRevisionRepository実装を作成する
+func NewTaskRevisionRepository() domain.TaskRevisionRepository {
+	return &taskRevisionRepository{}
+}
+
+// Createはリビジョンを保存する
+func (r *taskRevisionRepository) Create(ctx context.Context, ex domain.Executor, revision *domain.TaskRevision) error {
+	m, err := model.TaskRevisionFromDomain(revision)
+	if err != nil {
+		return fmt.Errorf("failed to encode task revision: %w", err)
+	}
+
+	query := `
+		INSERT INTO task_revisions (` + taskRevisionColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err = ex.ExecContext(ctx, query,
+		m.TaskID,
+		m.Revision,
+		m.Title,
+		m.Description,
+		m.StartDate,
+		m.DueDate,
+		m.DueAllDay,
+		m.Status,
+		m.Priority,
+		m.AssigneeIDs,
+		m.AssigneeGroupIDs,
+		m.ChangedBy,
+		m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create task revision: %w", err)
+	}
+
+	return nil
+}
+
+// FindByTaskIDはタスクのリビジョン一覧を新しい順に取得する
+func (r *taskRevisionRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskRevision, error) {
+	query := `
+		SELECT ` + taskRevisionColumns + `
+		FROM task_revisions
+		WHERE task_id = ?
+		ORDER BY revision DESC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task revisions: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var revisions []*domain.TaskRevision
+	for rows.Next() {
+		revision, err := scanTaskRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// FindByRevisionはタスクの指定したリビジョンを取得する
+func (r *taskRevisionRepository) FindByRevision(ctx context.Context, ex domain.Executor, taskID, revision int64) (*domain.TaskRevision, error) {
+	query := `
+		SELECT ` + taskRevisionColumns + `
+		FROM task_revisions
+		WHERE task_id = ? AND revision = ?
+	`
+
+	found, err := scanTaskRevision(ex.QueryRowContext(ctx, query, taskID, revision))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to find task revision: %w", err)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/textsearch"
+)
+
+// booleanOperatorsはMySQLのBOOLEAN MODEで演算子として扱われる文字
+const booleanOperators = `+-<>()~*"@`
+
+type taskSearchIndex struct{}
+
+// NewTaskSearchIndexはtasksテーブルのFULLTEXTインデックスを使うTaskSearchIndex実装を作成する
+func NewTaskSearchIndex() domain.TaskSearchIndex {
+	return &taskSearchIndex{}
+}
+
+// IndexはFULLTEXTインデックスがタスクの保存時に更新されるため何もしない
+func (i *taskSearchIndex) Index(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	return nil
+}
+
+// RemoveはFULLTEXTインデックスがタスクの削除時に更新されるため何もしない
+func (i *taskSearchIndex) Remove(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+// Searchはタイトル・説明のFULLTEXT検索で閲覧可能なタスクを取得する
+// タイトルでの一致は説明での一致より2倍のスコアとする
+func (i *taskSearchIndex) Search(ctx context.Context, ex domain.Executor, userID int64, query string, limit int) ([]domain.TaskSearchHit, error) {
+	against := booleanQuery(query)
+	if against == "" {
+		return nil, nil
+	}
+
+	sqlQuery := `
+		SELECT tasks.id,
+		  MATCH(tasks.title) AGAINST (? IN BOOLEAN MODE) * 2
+		    + MATCH(tasks.title, tasks.description) AGAINST (? IN BOOLEAN MODE) AS score
+		FROM tasks
+		WHERE deleted_at IS NULL
+		  AND MATCH(tasks.title, tasks.description) AGAINST (? IN BOOLEAN MODE)
+		  AND ` + visibleTaskCondition + `
+		ORDER BY score DESC, tasks.updated_at DESC
+		LIMIT ?
+	`
+
+	args := append([]any{against, against, against}, visibleTaskArgs(userID)...)
+	args = append(args, limit)
+	rows, err := ex.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var hits []domain.TaskSearchHit
+	for rows.Next() {
+		var hit domain.TaskSearchHit
+		if err := rows.Scan(&hit.TaskID, &hit.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search hits: %w", err)
+	}
+
+	return hits, nil
+}
+
+// booleanQueryは検索語をすべて必須とするBOOLEAN MODEのクエリを作成する
+// 利用者の入力が演算子として解釈されないよう、演算子の文字を除いてフレーズとして指定する
+func booleanQuery(query string) string {
+	var parts []string
+	for _, term := range textsearch.Terms(query) {
+		term = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(booleanOperators, r) {
+				return -1
+			}
+			return r
+		}, term)
+		if term != "" {
+			parts = append(parts, `+"`+term+`"`)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type taskShareRepository struct{}
+
+// NewTaskShareRepositoryは新しいTaskShareRepository実装を作成する
+func NewTaskShareRepository() domain.TaskShareRepository {
+	return &taskShareRepository{}
+}
+
+// Upsertは共有設定を作成し、既に共有済みの場合は権限を更新する
+func (r *taskShareRepository) Upsert(ctx context.Context, ex domain.Executor, share *domain.TaskShare) error {
+	m := model.TaskShareFromDomain(share)
+
+	query := `
+		INSERT INTO task_shares (task_id, user_id, permission, shared_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE permission = VALUES(permission), shared_by = VALUES(shared_by), updated_at = VALUES(updated_at)
+	`
+
+	_, err := ex.ExecContext(ctx, query,
+		m.TaskID,
+		m.UserID,
+		m.Permission,
+		m.SharedBy,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert task share: %w", err)
+	}
+
+	return nil
+}
+
+// Deleteは共有設定を削除する
+func (r *taskShareRepository) Delete(ctx context.Context, ex domain.Executor, taskID, userID int64) error {
+	query := `
+		DELETE FROM task_shares
+		WHERE task_id = ? AND user_id = ?
+	`
+
+	result, err := ex.ExecContext(ctx, query, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task share: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrShareNotFound
+	}
+
+	return nil
+}
+
+// FindByTaskIDはタスクの共有設定一覧を取得する
+func (r *taskShareRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskShare, error) {
+	query := `
+		SELECT task_id, user_id, permission, shared_by, created_at, updated_at
+		FROM task_shares
+		WHERE task_id = ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := ex.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task shares: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var shares []*domain.TaskShare
+	for rows.Next() {
+		var m model.TaskShare
+		err := rows.Scan(
+			&m.TaskID,
+			&m.UserID,
+			&m.Permission,
+			&m.SharedBy,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task share: %w", err)
+		}
+		shares = append(shares, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// DeleteByTaskIDはタスクのすべての共有設定を削除する
+func (r *taskShareRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	query := `
+		DELETE FROM task_shares
+		WHERE task_id = ?
+	`
+
+	_, err := ex.ExecContext(ctx, query, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to delete task shares: %w", err)
+	}
+
+	return nil
+}
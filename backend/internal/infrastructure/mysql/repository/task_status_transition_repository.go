@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/model"
+)
+
+type taskStatusTransitionRepository struct{}
+
+// NewTaskStatusTransitionRepositoryは新しいTaskStatusTransitionRepository実装を作成する
+func NewTaskStatusTransitionRepository() domain.TaskStatusTransitionRepository {
+	return &taskStatusTransitionRepository{}
+}
+
+// Createはステータス遷移を保存する
+func (r *taskStatusTransitionRepository) Create(ctx context.Context, ex domain.Executor, transition *domain.TaskStatusTransition) error {
+	m := model.TaskStatusTransitionFromDomain(transition)
+
+	query := `
+		INSERT INTO task_status_transitions (task_id, from_status, to_status, changed_by, transitioned_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := ex.ExecContext(ctx, query,
+		m.TaskID,
+		m.FromStatus,
+		m.ToStatus,
+		m.ChangedBy,
+		m.TransitionedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create task status transition: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	transition.ID = id
+
+	return nil
+}
+
+// FindVisibleByUserIDはユーザーが閲覧可能な（削除されていない）タスクのuntilより前の遷移を、タスクID・遷移日時の順に取得する
+func (r *taskStatusTransitionRepository) FindVisibleByUserID(ctx context.Context, ex domain.Executor, userID int64, until time.Time) ([]*domain.TaskStatusTransition, error) {
+	query := `
+		SELECT task_status_transitions.id, task_status_transitions.task_id, task_status_transitions.from_status,
+		       task_status_transitions.to_status, task_status_transitions.changed_by, task_status_transitions.transitioned_at
+		FROM task_status_transitions
+		JOIN tasks ON tasks.id = task_status_transitions.task_id
+		WHERE tasks.deleted_at IS NULL
+		  AND task_status_transitions.transitioned_at < ?
+		  AND ` + visibleTaskCondition + `
+		ORDER BY task_status_transitions.task_id, task_status_transitions.transitioned_at, task_status_transitions.id
+	`
+
+	args := append([]any{until.UTC()}, visibleTaskArgs(userID)...)
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task status transitions: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var transitions []*domain.TaskStatusTransition
+	for rows.Next() {
+		var m model.TaskStatusTransition
+		if err := rows.Scan(&m.ID, &m.TaskID, &m.FromStatus, &m.ToStatus, &m.ChangedBy, &m.TransitionedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task status transition: %w", err)
+		}
+		transitions = append(transitions, m.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task status transitions: %w", err)
+	}
+
+	return transitions, nil
+}
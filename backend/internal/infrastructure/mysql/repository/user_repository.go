@@ -23,15 +23,17 @@ func (r *userRepository) Create(ctx context.Context, ex domain.Executor, user *d
 	m := model.UserFromDomain(user)
 
 	query := `
-		INSERT INTO users (email, password_hash, name, token_version, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO users (email, password_hash, name, timezone, token_version, email_verified_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	result, err := ex.ExecContext(ctx, query,
 		m.Email,
 		m.PasswordHash,
 		m.Name,
+		m.Timezone,
 		m.TokenVersion,
+		m.EmailVerifiedAt,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -51,7 +53,7 @@ func (r *userRepository) Create(ctx context.Context, ex domain.Executor, user *d
 // FindByIDはIDでユーザーを取得する
 func (r *userRepository) FindByID(ctx context.Context, ex domain.Executor, id int64) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, token_version, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, name, timezone, token_version, email_verified_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE id = ? AND deleted_at IS NULL
 	`
@@ -64,7 +66,9 @@ func (r *userRepository) FindByID(ctx context.Context, ex domain.Executor, id in
 		&m.Email,
 		&m.PasswordHash,
 		&m.Name,
+		&m.Timezone,
 		&m.TokenVersion,
+		&m.EmailVerifiedAt,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.DeletedAt,
@@ -82,7 +86,7 @@ func (r *userRepository) FindByID(ctx context.Context, ex domain.Executor, id in
 // FindByEmailはメールアドレスでユーザーを取得する
 func (r *userRepository) FindByEmail(ctx context.Context, ex domain.Executor, email string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, token_version, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, name, timezone, token_version, email_verified_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE email = ? AND deleted_at IS NULL
 	`
@@ -95,7 +99,9 @@ func (r *userRepository) FindByEmail(ctx context.Context, ex domain.Executor, em
 		&m.Email,
 		&m.PasswordHash,
 		&m.Name,
+		&m.Timezone,
 		&m.TokenVersion,
+		&m.EmailVerifiedAt,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.DeletedAt,
@@ -113,7 +119,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, ex domain.Executor, em
 // FindAllは全ユーザーを取得する
 func (r *userRepository) FindAll(ctx context.Context, ex domain.Executor) ([]*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, token_version, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, name, timezone, token_version, email_verified_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE deleted_at IS NULL
 		ORDER BY created_at DESC
@@ -135,7 +141,9 @@ func (r *userRepository) FindAll(ctx context.Context, ex domain.Executor) ([]*do
 			&m.Email,
 			&m.PasswordHash,
 			&m.Name,
+			&m.Timezone,
 			&m.TokenVersion,
+			&m.EmailVerifiedAt,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 			&m.DeletedAt,
@@ -159,7 +167,7 @@ func (r *userRepository) Update(ctx context.Context, ex domain.Executor, user *d
 
 	query := `
 		UPDATE users
-		SET email = ?, password_hash = ?, name = ?, token_version = ?, updated_at = ?
+		SET email = ?, password_hash = ?, name = ?, timezone = ?, token_version = ?, email_verified_at = ?, updated_at = ?
 		WHERE id = ? AND deleted_at IS NULL
 	`
 
@@ -167,7 +175,9 @@ func (r *userRepository) Update(ctx context.Context, ex domain.Executor, user *d
 		m.Email,
 		m.PasswordHash,
 		m.Name,
+		m.Timezone,
 		m.TokenVersion,
+		m.EmailVerifiedAt,
 		m.UpdatedAt,
 		m.ID,
 	)
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// LogNotifierは通知内容をログに出力するdomain.Notifierの実装
+type LogNotifier struct{}
+
+// NewLogNotifierで新しいLogNotifierを作成
+func NewLogNotifier() domain.Notifier {
+	return &LogNotifier{}
+}
+
+// NotifySLAEscalationはSLAエスカレーションをログに出力する
+func (n *LogNotifier) NotifySLAEscalation(ctx context.Context, owner *domain.User, task *domain.Task, status domain.SLAStatus) error {
+	log.Printf("[SLA] notify user=%d <%s>: task=%d %q priority=%d breached=%t startDeadline=%s resolveDeadline=%s",
+		owner.ID,
+		owner.Email,
+		task.ID,
+		task.Title,
+		task.Priority,
+		status.Breached(),
+		status.StartDeadline.Format(time.RFC3339),
+		status.ResolveDeadline.Format(time.RFC3339),
+	)
+	return nil
+}
@@ -0,0 +1,169 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/textsearch"
+)
+
+// titleWeightはタイトルでの一致に掛ける重み（MySQL実装と同じく説明の2倍）
+const titleWeight = 2
+
+// VisibilityFuncはユーザーがタスクを閲覧できるかを判定する
+type VisibilityFunc func(ctx context.Context, ex domain.Executor, userID, taskID int64) (bool, error)
+
+// postingは1つのトークンが1つのタスクに現れる回数
+type posting struct {
+	title       int
+	description int
+}
+
+// memoryTaskSearchIndexはプロセス内の転置インデックスによるTaskSearchIndex実装（テストやDBを使わない環境向け）
+type memoryTaskSearchIndex struct {
+	mu       sync.RWMutex
+	visible  VisibilityFunc
+	postings map[string]map[int64]posting
+	docs     map[int64][]string
+}
+
+// NewMemoryTaskSearchIndexは新しいインメモリのTaskSearchIndexを作成する
+// 検索結果はvisibleで閲覧可能と判定されたタスクに絞り込む
+func NewMemoryTaskSearchIndex(visible VisibilityFunc) domain.TaskSearchIndex {
+	return &memoryTaskSearchIndex{
+		visible:  visible,
+		postings: make(map[string]map[int64]posting),
+		docs:     make(map[int64][]string),
+	}
+}
+
+// Indexはタスクのタイトル・説明をトークンに分割して登録する
+func (i *memoryTaskSearchIndex) Index(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(task.ID)
+
+	counts := make(map[string]posting)
+	for _, token := range textsearch.Tokenize(task.Title) {
+		p := counts[token]
+		p.title++
+		counts[token] = p
+	}
+	if task.Description != nil {
+		for _, token := range textsearch.Tokenize(*task.Description) {
+			p := counts[token]
+			p.description++
+			counts[token] = p
+		}
+	}
+
+	tokens := make([]string, 0, len(counts))
+	for token, p := range counts {
+		if i.postings[token] == nil {
+			i.postings[token] = make(map[int64]posting)
+		}
+		i.postings[token][task.ID] = p
+		tokens = append(tokens, token)
+	}
+	i.docs[task.ID] = tokens
+
+	return nil
+}
+
+// Removeはタスクを索引から削除する
+func (i *memoryTaskSearchIndex) Remove(ctx context.Context, ex domain.Executor, taskID int64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(taskID)
+	return nil
+}
+
+// removeはロックを取得済みの状態でタスクの登録を削除する
+func (i *memoryTaskSearchIndex) remove(taskID int64) {
+	for _, token := range i.docs[taskID] {
+		delete(i.postings[token], taskID)
+		if len(i.postings[token]) == 0 {
+			delete(i.postings, token)
+		}
+	}
+	delete(i.docs, taskID)
+}
+
+// Searchはクエリのすべての語を含むタスクをTF-IDFのスコア順に返す
+func (i *memoryTaskSearchIndex) Search(ctx context.Context, ex domain.Executor, userID int64, query string, limit int) ([]domain.TaskSearchHit, error) {
+	candidates := i.match(query)
+
+	hits := make([]domain.TaskSearchHit, 0, len(candidates))
+	for taskID, score := range candidates {
+		ok, err := i.visible(ctx, ex, userID, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			hits = append(hits, domain.TaskSearchHit{TaskID: taskID, Score: score})
+		}
+	}
+
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].TaskID > hits[b].TaskID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+// matchはクエリのすべての語のトークンを含むタスクとスコアを返す
+func (i *memoryTaskSearchIndex) match(query string) map[int64]float64 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var scores map[int64]float64
+	total := float64(len(i.docs))
+	for _, term := range textsearch.Terms(query) {
+		tokens := textsearch.Tokenize(term)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		termScores := make(map[int64]float64)
+		for n, token := range tokens {
+			postings := i.postings[token]
+			idf := math.Log(1 + total/float64(len(postings)+1))
+			next := make(map[int64]float64)
+			for taskID, p := range postings {
+				if n > 0 {
+					if _, ok := termScores[taskID]; !ok {
+						continue
+					}
+				}
+				next[taskID] = termScores[taskID] + float64(p.title*titleWeight+p.description)*idf
+			}
+			termScores = next
+		}
+
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for taskID := range scores {
+			score, ok := termScores[taskID]
+			if !ok {
+				delete(scores, taskID)
+				continue
+			}
+			scores[taskID] += score
+		}
+	}
+
+	return scores
+}
@@ -1,22 +1,34 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
+	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
 	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
 	authuc "github.com/ryusuke/task_app_layerx/internal/usecase/auth"
+	idempotencyuc "github.com/ryusuke/task_app_layerx/internal/usecase/idempotency"
 )
 
+// signupIdempotencyUserIDはユーザー登録の冪等キーの名前空間（認証不要のため全クライアントで共通）
+const signupIdempotencyUserID = 0
+
 // AuthHandlerは認証関連のHTTPハンドラー
 type AuthHandler struct {
-	authUseCase *authuc.AuthUseCase
+	authUseCase        *authuc.AuthUseCase
+	idempotencyUseCase *idempotencyuc.IdempotencyUseCase
 }
 
 // NewAuthHandlerで新しいAuthHandlerを作成
-func NewAuthHandler(authUseCase *authuc.AuthUseCase) *AuthHandler {
+func NewAuthHandler(authUseCase *authuc.AuthUseCase, idempotencyUseCase *idempotencyuc.IdempotencyUseCase) *AuthHandler {
 	return &AuthHandler{
-		authUseCase: authUseCase,
+		authUseCase:        authUseCase,
+		idempotencyUseCase: idempotencyUseCase,
 	}
 }
 
@@ -32,9 +44,16 @@ func (h *AuthHandler) Signup(c echo.Context) error {
 	}
 
 	usecaseRequest := authuc.SignupRequest{
-		Email:    request.Email,
-		Password: request.Password,
-		Name:     request.Name,
+		Email:     request.Email,
+		Password:  request.Password,
+		Name:      request.Name,
+		Timezone:  request.Timezone,
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
+	}
+
+	if key := c.Request().Header.Get("Idempotency-Key"); key != "" {
+		return h.signupIdempotently(c, key, usecaseRequest)
 	}
 
 	resp, err := h.authUseCase.Signup(c.Request().Context(), usecaseRequest)
@@ -42,14 +61,56 @@ func (h *AuthHandler) Signup(c echo.Context) error {
 		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusCreated, AuthResponse{
-		Token: resp.Token,
-		User: UserResponse{
-			ID:    resp.User.ID,
-			Email: resp.User.Email,
-			Name:  resp.User.Name,
-		},
-	})
+	return c.JSON(http.StatusCreated, toAuthResponse(resp))
+}
+
+// signupIdempotentlyはIdempotency-Keyを指定したユーザー登録を処理する
+// トークンを含むレスポンスは保存せず、作成したユーザーのIDとリクエストの識別用ハッシュのみを保存し、
+// リトライ時はパスワードを確認したうえで新しいトークンを発行する
+func (h *AuthHandler) signupIdempotently(c echo.Context, key string, req authuc.SignupRequest) error {
+	ctx := c.Request().Context()
+
+	// パスワードは識別用ハッシュに含めない（リトライ時は登録したユーザーのパスワードハッシュで確認する）
+	identity, err := json.Marshal([]string{req.Email, req.Name, req.Timezone})
+	if err != nil {
+		return HandleError(c, err)
+	}
+	fingerprint := domain.IdempotencyFingerprint(c.Request().Method, c.Request().URL.RequestURI(), identity)
+
+	stored, err := h.idempotencyUseCase.Begin(ctx, signupIdempotencyUserID, key, fingerprint)
+	if err != nil {
+		return HandleError(c, err)
+	}
+	if stored != nil {
+		// ユーザーのIDを保存していない記録は、ユーザー登録以外のリクエストのもの
+		if stored.ResourceID == nil {
+			return HandleError(c, domain.ErrIdempotencyKeyReused)
+		}
+		resp, err := h.authUseCase.ReplaySignup(ctx, *stored.ResourceID, req)
+		if err != nil {
+			return HandleError(c, err)
+		}
+		c.Response().Header().Set("Idempotent-Replayed", "true")
+		return c.JSON(stored.StatusCode, toAuthResponse(resp))
+	}
+
+	// クライアントが切断しても処理結果は保存する
+	saveCtx := context.WithoutCancel(ctx)
+
+	resp, err := h.authUseCase.Signup(ctx, req)
+	if err != nil {
+		// 失敗したリクエストは保存せず、同じキーでリトライできるようにする
+		if releaseErr := h.idempotencyUseCase.Release(saveCtx, signupIdempotencyUserID, key); releaseErr != nil {
+			log.Printf("failed to release idempotency key: %v", releaseErr)
+		}
+		return HandleError(c, err)
+	}
+
+	if err := h.idempotencyUseCase.CompleteWithResource(saveCtx, signupIdempotencyUserID, key, http.StatusCreated, resp.User.ID); err != nil {
+		log.Printf("failed to save idempotent signup: %v", err)
+	}
+
+	return c.JSON(http.StatusCreated, toAuthResponse(resp))
 }
 
 // Loginはログインを処理
@@ -64,8 +125,10 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	}
 
 	usecaseRequest := authuc.LoginRequest{
-		Email:    request.Email,
-		Password: request.Password,
+		Email:     request.Email,
+		Password:  request.Password,
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
 	}
 
 	resp, err := h.authUseCase.Login(c.Request().Context(), usecaseRequest)
@@ -73,17 +136,116 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, AuthResponse{
-		Token: resp.Token,
-		User: UserResponse{
-			ID:    resp.User.ID,
-			Email: resp.User.Email,
-			Name:  resp.User.Name,
-		},
+	return c.JSON(http.StatusOK, toAuthResponse(resp))
+}
+
+// Refreshはリフレッシュトークンでアクセストークンを再発行する（リフレッシュトークンも新しいものに置き換わる）
+// POST /auth/refresh
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var request RefreshRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.authUseCase.Refresh(c.Request().Context(), authuc.RefreshRequest{
+		RefreshToken: request.RefreshToken,
+		UserAgent:    c.Request().UserAgent(),
+		IPAddress:    c.RealIP(),
 	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toAuthResponse(resp))
 }
 
-// Logoutはログアウトを処理
+// ForgotPasswordはパスワード再設定用のリンクをメールで送る
+// メールアドレスが登録されているかどうかにかかわらず202を返す
+// POST /auth/password/forgot
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	var request ForgotPasswordRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	if err := h.authUseCase.ForgotPassword(c.Request().Context(), authuc.ForgotPasswordRequest{
+		Email: request.Email,
+	}); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// ResetPasswordはメールで送ったトークンでパスワードを再設定する（全端末でログアウトされる）
+// POST /auth/password/reset
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	var request ResetPasswordRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	if err := h.authUseCase.ResetPassword(c.Request().Context(), authuc.ResetPasswordRequest{
+		Token:    request.Token,
+		Password: request.Password,
+	}); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// VerifyEmailはメールで送った確認リンクのトークンでメールアドレスを確認済みにする
+// POST /auth/email/verify
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	var request VerifyEmailRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	if err := h.authUseCase.VerifyEmail(c.Request().Context(), authuc.VerifyEmailRequest{
+		Token: request.Token,
+	}); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResendVerificationEmailはメールアドレス確認リンクのメールを再送する
+// メールアドレスが登録されているかどうか・確認済みかどうかにかかわらず202を返す
+// POST /auth/email/resend
+func (h *AuthHandler) ResendVerificationEmail(c echo.Context) error {
+	var request ResendVerificationRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	if err := h.authUseCase.ResendVerificationEmail(c.Request().Context(), authuc.ResendVerificationRequest{
+		Email: request.Email,
+	}); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// Logoutは現在のセッションのログアウトを処理（他の端末のセッションは有効なまま）
 // POST /auth/logout
 func (h *AuthHandler) Logout(c echo.Context) error {
 	// JWTミドルウェアでセットされたユーザーIDを取得
@@ -96,13 +258,67 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	}
 
 	// UseCaseを呼び出し
-	if err := h.authUseCase.Logout(c.Request().Context(), userID); err != nil {
+	if err := h.authUseCase.Logout(c.Request().Context(), userID, middleware.GetSessionID(c)); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListSessionsはログイン中のユーザーの有効なセッション一覧を取得
+// GET /auth/sessions
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	sessions, err := h.authUseCase.ListSessions(c.Request().Context(), middleware.GetUserID(c), middleware.GetSessionID(c))
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	response := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		response[i] = SessionResponse{
+			ID:         session.ID,
+			Device:     session.Device,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt.Format(time.RFC3339),
+			LastUsedAt: session.LastUsedAt.Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.Format(time.RFC3339),
+			Current:    session.Current,
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RevokeSessionは指定したセッションを無効にする（その端末のみログアウトされる）
+// DELETE /auth/sessions/:id
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	sessionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_SESSION_ID",
+			Message: "invalid session id",
+		})
+	}
+
+	if err := h.authUseCase.RevokeSession(c.Request().Context(), middleware.GetUserID(c), sessionID); err != nil {
 		return HandleError(c, err)
 	}
 
 	return c.NoContent(http.StatusNoContent)
 }
 
+// RevokeOtherSessionsは現在のセッション以外をすべて無効にする（他の端末からログアウト）
+// POST /auth/sessions/revoke-others
+func (h *AuthHandler) RevokeOtherSessions(c echo.Context) error {
+	revoked, err := h.authUseCase.RevokeOtherSessions(c.Request().Context(), middleware.GetUserID(c), middleware.GetSessionID(c))
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, RevokeSessionsResponse{Revoked: revoked})
+}
+
 // GetUsersはユーザー一覧を取得
 // GET /users
 func (h *AuthHandler) GetUsers(c echo.Context) error {
@@ -113,3 +329,55 @@ func (h *AuthHandler) GetUsers(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, users)
 }
+
+// GetMeはログイン中のユーザー情報を取得
+// GET /users/me
+func (h *AuthHandler) GetMe(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	user, err := h.authUseCase.GetMe(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// UpdateMeはログイン中のユーザーのプロフィール（名前・タイムゾーン）を更新
+// PATCH /users/me
+func (h *AuthHandler) UpdateMe(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	var request UpdateProfileRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	user, err := h.authUseCase.UpdateProfile(c.Request().Context(), userID, authuc.UpdateProfileRequest{
+		Name:     request.Name,
+		Timezone: request.Timezone,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// toAuthResponseはユースケースの認証結果をレスポンスに変換
+func toAuthResponse(resp *authuc.AuthResponse) AuthResponse {
+	return AuthResponse{
+		Token:        resp.Token,
+		RefreshToken: resp.RefreshToken,
+		User: UserResponse{
+			ID:            resp.User.ID,
+			Email:         resp.User.Email,
+			Name:          resp.User.Name,
+			Timezone:      resp.User.Timezone,
+			EmailVerified: resp.User.EmailVerified,
+		},
+	}
+}
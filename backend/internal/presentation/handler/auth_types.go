@@ -5,6 +5,7 @@ type SignupRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8"`
 	Name     string `json:"name" validate:"required"`
+	Timezone string `json:"timezone"`
 }
 
 // LoginRequest はログインのリクエスト
@@ -13,16 +14,68 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+// RefreshRequest はアクセストークン再発行のリクエスト
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// ForgotPasswordRequest はパスワード再設定メール送信のリクエスト
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest はパスワード再設定のリクエスト
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+// VerifyEmailRequest はメールアドレス確認のリクエスト
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResendVerificationRequest は確認メール再送のリクエスト
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 // AuthResponse は認証成功時のレスポンス
+// メールアドレス未確認のユーザーがログインできない設定での登録時は、トークンを含めない
 type AuthResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token        string       `json:"token,omitempty"`
+	RefreshToken string       `json:"refreshToken,omitempty"`
+	User         UserResponse `json:"user"`
 }
 
 // UserResponse はユーザー情報のレスポンス
 type UserResponse struct {
-	ID    int64  `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	ID            int64  `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Timezone      string `json:"timezone"`
+	EmailVerified bool   `json:"emailVerified"`
+}
+
+// UpdateProfileRequest はプロフィール更新のリクエスト
+type UpdateProfileRequest struct {
+	Name     *string `json:"name"`
+	Timezone *string `json:"timezone"`
+}
+
+// SessionResponse はログインセッションのレスポンス
+type SessionResponse struct {
+	ID         int64  `json:"id"`
+	Device     string `json:"device"`
+	UserAgent  string `json:"userAgent"`
+	IPAddress  string `json:"ipAddress"`
+	CreatedAt  string `json:"createdAt"`
+	LastUsedAt string `json:"lastUsedAt"`
+	ExpiresAt  string `json:"expiresAt"`
+	Current    bool   `json:"current"`
 }
 
+// RevokeSessionsResponse は他の端末からのログアウトのレスポンス
+type RevokeSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
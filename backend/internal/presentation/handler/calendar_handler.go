@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	calendaruc "github.com/ryusuke/task_app_layerx/internal/usecase/calendar"
+	"github.com/ryusuke/task_app_layerx/pkg/ical"
+)
+
+// CalendarHandlerはカレンダーアプリ向けicsフィードのHTTPハンドラー
+type CalendarHandler struct {
+	calendarUseCase *calendaruc.CalendarUseCase
+}
+
+// NewCalendarHandlerで新しいCalendarHandlerを作成
+func NewCalendarHandler(calendarUseCase *calendaruc.CalendarUseCase) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUseCase: calendarUseCase,
+	}
+}
+
+// RegenerateTokenはフィード用トークンを発行（再発行すると以前のURLは無効になる）
+// POST /calendar/token
+func (h *CalendarHandler) RegenerateToken(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	resp, err := h.calendarUseCase.RegenerateToken(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, CalendarTokenResponse{
+		Token:     resp.Token,
+		FeedURL:   fmt.Sprintf("%s://%s/api/v1/calendar/feed/%s.ics", c.Scheme(), c.Request().Host, resp.Token),
+		CreatedAt: resp.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+// RevokeTokenはフィード用トークンを無効化
+// DELETE /calendar/token
+func (h *CalendarHandler) RevokeToken(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	if err := h.calendarUseCase.RevokeToken(c.Request().Context(), userID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetFeedは期日付きタスクをicsで返す（カレンダーアプリはBearerトークンを送れないため、URLのトークンで認証する）
+// GET /calendar/feed/:token?component=todo|event
+func (h *CalendarHandler) GetFeed(c echo.Context) error {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	component := ical.ComponentTodo
+	switch c.QueryParam("component") {
+	case "", "todo":
+	case "event":
+		component = ical.ComponentEvent
+	default:
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "component must be todo or event",
+			Details: map[string]interface{}{"field": "component"},
+		})
+	}
+
+	resp, err := h.calendarUseCase.GetFeed(c.Request().Context(), token)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	items := make([]ical.Item, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		items[i] = ical.Item{
+			UID:          fmt.Sprintf("task-%d@task_app_layerx", task.ID),
+			Summary:      task.Title,
+			Description:  stringOrEmpty(task.Description),
+			Due:          task.DueDate,
+			AllDay:       task.DueAllDay,
+			Status:       toICalStatus(task.Status),
+			Priority:     toICalPriority(task.Priority),
+			Categories:   []string{task.Status},
+			Completed:    task.CompletedAt,
+			LastModified: task.UpdatedAt,
+		}
+	}
+
+	calendar := &ical.Calendar{
+		ProdID:    "-//task_app_layerx//Tasks//JA",
+		Name:      resp.UserName + "のタスク",
+		Component: component,
+		Items:     items,
+		Now:       resp.GeneratedAt,
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/calendar; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, `inline; filename="tasks.ics"`)
+	res.WriteHeader(http.StatusOK)
+	return calendar.Encode(res)
+}
+
+// toICalStatusはタスクのステータスをVTODOのSTATUSに変換
+func toICalStatus(status string) ical.TodoStatus {
+	switch status {
+	case "IN_PROGRESS":
+		return ical.TodoStatusInProcess
+	case "DONE":
+		return ical.TodoStatusCompleted
+	default:
+		return ical.TodoStatusNeedsAction
+	}
+}
+
+// toICalPriorityはタスクの優先度（0〜5、5が最高）をiCalendarのPRIORITY（1が最高、9が最低、0は未定義）に変換
+func toICalPriority(priority int) int {
+	if priority <= 0 {
+		return 0
+	}
+	return 11 - 2*priority
+}
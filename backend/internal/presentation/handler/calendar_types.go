@@ -0,0 +1,8 @@
+package handler
+
+// CalendarTokenResponseはカレンダーフィード用トークン発行のレスポンス
+type CalendarTokenResponse struct {
+	Token     string `json:"token"`
+	FeedURL   string `json:"feedUrl"`
+	CreatedAt string `json:"createdAt"`
+}
@@ -17,129 +17,398 @@ type ErrorResponse struct {
 
 // HandleErrorはDomainエラーをHTTPエラーに変換
 func HandleError(c echo.Context, err error) error {
+	status, resp := toErrorResponse(err)
+	return c.JSON(status, resp)
+}
+
+// toErrorResponseはDomainエラーをHTTPステータスとErrorResponseに変換
+func toErrorResponse(err error) (int, ErrorResponse) {
 	if errors.Is(err, domain.ErrUnauthorized) {
-		return c.JSON(http.StatusUnauthorized, ErrorResponse{
+		return http.StatusUnauthorized, ErrorResponse{
 			Code:    "UNAUTHORIZED",
 			Message: "unauthorized",
-		})
+		}
 	}
 	// トークンエラー (401)
 	if errors.Is(err, domain.ErrInvalidToken) {
-		return c.JSON(http.StatusUnauthorized, ErrorResponse{
+		return http.StatusUnauthorized, ErrorResponse{
 			Code:    "INVALID_TOKEN",
 			Message: "invalid or expired token",
-		})
+		}
+	}
+	// 置き換え済みのリフレッシュトークンの再利用 (401)
+	if errors.Is(err, domain.ErrRefreshTokenReused) {
+		return http.StatusUnauthorized, ErrorResponse{
+			Code:    "REFRESH_TOKEN_REUSED",
+			Message: "refresh token has already been used, please login again",
+		}
 	}
 	// トークンが期限切れ (401)	
 	if errors.Is(err, domain.ErrTokenExpired) {
-		return c.JSON(http.StatusUnauthorized, ErrorResponse{
+		return http.StatusUnauthorized, ErrorResponse{
 			Code:    "TOKEN_EXPIRED",
 			Message: "token has expired",
-		})
+		}
+	}
+
+	// パスワード再設定トークンが無効・期限切れ・使用済み (400)
+	if errors.Is(err, domain.ErrInvalidResetToken) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_RESET_TOKEN",
+			Message: "password reset link is invalid or has expired",
+		}
 	}
 
+	// メールアドレス確認トークンが無効・期限切れ (400)
+	if errors.Is(err, domain.ErrInvalidVerificationToken) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_VERIFICATION_TOKEN",
+			Message: "email verification link is invalid or has expired",
+		}
+	}
+
+	// メールアドレス未確認のためログインできない (403)
+	if errors.Is(err, domain.ErrEmailNotVerified) {
+		return http.StatusForbidden, ErrorResponse{
+			Code:    "EMAIL_NOT_VERIFIED",
+			Message: "email address has not been verified",
+		}
+	}
 	// 権限がない (403)
 	if errors.Is(err, domain.ErrForbidden) {
-		return c.JSON(http.StatusForbidden, ErrorResponse{
+		return http.StatusForbidden, ErrorResponse{
 			Code:    "FORBIDDEN",
 			Message: "forbidden",
-		})
+		}
 	}
 
 	// リソースが見つからない (404)
 	if errors.Is(err, domain.ErrNotFound) ||
 		errors.Is(err, domain.ErrUserNotFound) ||
-		errors.Is(err, domain.ErrTaskNotFound) {
-		return c.JSON(http.StatusNotFound, ErrorResponse{
+		errors.Is(err, domain.ErrTaskNotFound) ||
+		errors.Is(err, domain.ErrRevisionNotFound) ||
+		errors.Is(err, domain.ErrGroupNotFound) ||
+		errors.Is(err, domain.ErrGroupMemberNotFound) ||
+		errors.Is(err, domain.ErrShareNotFound) ||
+		errors.Is(err, domain.ErrDependencyNotFound) ||
+		errors.Is(err, domain.ErrCalendarTokenNotFound) ||
+		errors.Is(err, domain.ErrSessionNotFound) ||
+		errors.Is(err, domain.ErrViewNotFound) ||
+		errors.Is(err, domain.ErrViewShareNotFound) {
+		return http.StatusNotFound, ErrorResponse{
 			Code:    "NOT_FOUND",
 			Message: "resource not found",
-		})
+		}
 	}
 
 	// メールアドレスが無効 (400)
 	if errors.Is(err, domain.ErrInvalidEmail) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "invalid email format",
 			Details: map[string]interface{}{"field": "email"},
-		})
+		}
 	}
 	// パスワードが無効 (400)
 	if errors.Is(err, domain.ErrInvalidPassword) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "invalid password",
 			Details: map[string]interface{}{"field": "password"},
-		})
+		}
 	}
 	// パスワードが短すぎる (400)
 	if errors.Is(err, domain.ErrPasswordTooShort) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "password must be at least 8 characters",
 			Details: map[string]interface{}{"field": "password"},
-		})
+		}
 	}
 	// 名前が無効 (400)
 	if errors.Is(err, domain.ErrInvalidName) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "name is required",
 			Details: map[string]interface{}{"field": "name"},
-		})
+		}
+	}
+	// タイムゾーンが無効 (400)
+	if errors.Is(err, domain.ErrInvalidTimezone) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "invalid timezone",
+			Details: map[string]interface{}{"field": "timezone"},
+		}
 	}
 	// タイトルが無効 (400)
 	if errors.Is(err, domain.ErrTitleRequired) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "title is required",
 			Details: map[string]interface{}{"field": "title"},
-		})
+		}
 	}
 	// タイトルが長すぎる (400)
 	if errors.Is(err, domain.ErrTitleTooLong) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "title must be less than 255 characters",
 			Details: map[string]interface{}{"field": "title"},
-		})
+		}
 	}
 	// 優先度が無効 (400)
 	if errors.Is(err, domain.ErrInvalidPriority) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "priority must be between 0 and 5",
 			Details: map[string]interface{}{"field": "priority"},
-		})
+		}
 	}
 	// ステータス遷移が無効 (400)
 	if errors.Is(err, domain.ErrInvalidStatusTransition) {
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
+		return http.StatusBadRequest, ErrorResponse{
 			Code:    "VALIDATION_ERROR",
 			Message: "invalid status transition",
 			Details: map[string]interface{}{"field": "status"},
-		})
+		}
+	}
+	// 開始日が期日より後 (400)
+	if errors.Is(err, domain.ErrStartAfterDue) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "start date must not be after due date",
+			Details: map[string]interface{}{"field": "startDate"},
+		}
+	}
+	// 期間が無効 (400)
+	if errors.Is(err, domain.ErrInvalidDateRange) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "invalid date range",
+		}
+	}
+	// 自分自身への依存 (400)
+	if errors.Is(err, domain.ErrSelfDependency) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "task cannot depend on itself",
+			Details: map[string]interface{}{"field": "dependsOnTaskId"},
+		}
+	}
+	// グループ名が無効 (400)
+	if errors.Is(err, domain.ErrInvalidGroupName) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "group name is required",
+			Details: map[string]interface{}{"field": "name"},
+		}
+	}
+	// グループ名が長すぎる (400)
+	if errors.Is(err, domain.ErrGroupNameTooLong) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "group name must be less than 100 characters",
+			Details: map[string]interface{}{"field": "name"},
+		}
+	}
+	// 共有権限が無効 (400)
+	if errors.Is(err, domain.ErrInvalidSharePermission) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "share permission must be VIEW",
+			Details: map[string]interface{}{"field": "permission"},
+		}
+	}
+	// オーナーへの共有 (400)
+	if errors.Is(err, domain.ErrCannotShareWithOwner) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "task cannot be shared with its owner",
+			Details: map[string]interface{}{"field": "userId"},
+		}
+	}
+	// ビュー名が無効 (400)
+	if errors.Is(err, domain.ErrInvalidViewName) || errors.Is(err, domain.ErrViewNameTooLong) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+			Details: map[string]interface{}{"field": "name"},
+		}
+	}
+	// ビューのオーナーへの共有 (400)
+	if errors.Is(err, domain.ErrCannotShareViewWithOwner) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "view cannot be shared with its owner",
+			Details: map[string]interface{}{"field": "userId"},
+		}
 	}
 
 	// メールアドレスが重複 (409)
 	if errors.Is(err, domain.ErrDuplicateEmail) {
-		return c.JSON(http.StatusConflict, ErrorResponse{
+		return http.StatusConflict, ErrorResponse{
 			Code:    "CONFLICT",
 			Message: "email already exists",
 			Details: map[string]interface{}{"field": "email"},
-		})
+		}
 	}
 	// アサイン先が重複 (409)
 	if errors.Is(err, domain.ErrDuplicateAssignee) {
-		return c.JSON(http.StatusConflict, ErrorResponse{
+		return http.StatusConflict, ErrorResponse{
 			Code:    "CONFLICT",
 			Message: "user already assigned to this task",
-		})
+		}
+	}
+	// グループメンバーが重複 (409)
+	if errors.Is(err, domain.ErrDuplicateGroupMember) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "CONFLICT",
+			Message: "user is already a member of this group",
+		}
+	}
+	// グループアサインが重複 (409)
+	if errors.Is(err, domain.ErrDuplicateGroupAssignee) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "CONFLICT",
+			Message: "group already assigned to this task",
+		}
+	}
+
+	// ビュー名が重複 (409)
+	if errors.Is(err, domain.ErrDuplicateViewName) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "CONFLICT",
+			Message: "view with the same name already exists",
+			Details: map[string]interface{}{"field": "name"},
+		}
+	}
+
+	// タスクが他の更新で変更されている (409)
+	// If-Matchを指定したリクエストはハンドラーが412と現在のタスクを返すため、ここに来るのは前提条件のない場合のみ
+	if errors.Is(err, domain.ErrTaskVersionMismatch) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "CONFLICT",
+			Message: "task has been modified by someone else",
+		}
+	}
+
+	// 依存関係が重複 (409)
+	if errors.Is(err, domain.ErrDuplicateDependency) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "CONFLICT",
+			Message: "dependency already exists",
+		}
+	}
+	// 依存関係が循環 (409)
+	if errors.Is(err, domain.ErrDependencyCycle) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "DEPENDENCY_CYCLE",
+			Message: "dependency would create a cycle",
+		}
+	}
+
+	// ステータスが無効 (400)
+	if errors.Is(err, domain.ErrInvalidStatus) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "status must be one of TODO, IN_PROGRESS, DONE",
+			Details: map[string]interface{}{"field": "status"},
+		}
+	}
+	// 絞り込みのロールが無効 (400)
+	if errors.Is(err, domain.ErrInvalidTaskRole) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+			Details: map[string]interface{}{"field": "role"},
+		}
+	}
+	// 並び順が無効 (400)
+	if errors.Is(err, domain.ErrInvalidSortKey) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+			Details: map[string]interface{}{"field": "sort"},
+		}
+	}
+	// カーソルが無効 (400)
+	if errors.Is(err, domain.ErrInvalidCursor) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_CURSOR",
+			Message: "cursor is invalid or does not match the sort order",
+			Details: map[string]interface{}{"field": "cursor"},
+		}
+	}
+	// 検索クエリが無効 (400)
+	if errors.Is(err, domain.ErrInvalidSearchQuery) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+			Details: map[string]interface{}{"field": "q"},
+		}
+	}
+	// アサイン先・グループメンバーに追加するユーザーがメールアドレス未確認 (400)
+	if errors.Is(err, domain.ErrAssigneeNotVerified) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "ASSIGNEE_NOT_VERIFIED",
+			Message: err.Error(),
+		}
+	}
+	// クイック追加のメンションがユーザーに対応しない (400)
+	if errors.Is(err, domain.ErrUnresolvedMention) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+			Details: map[string]interface{}{"field": "input"},
+		}
+	}
+	// インポートの行数が無効 (400)
+	if errors.Is(err, domain.ErrInvalidImport) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "import must contain 1 to 1000 rows",
+		}
+	}
+	// 一括操作のリクエストが無効 (400)
+	if errors.Is(err, domain.ErrInvalidBulkRequest) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "taskIds must contain 1 to 100 ids, mode must be atomic or best_effort, and either delete or at least one update must be specified",
+		}
+	}
+	// 一括操作で他のタスクが失敗したため未適用 (409)
+	if errors.Is(err, domain.ErrBulkAborted) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "ABORTED",
+			Message: "not applied because another task in the bulk operation failed",
+		}
+	}
+	// 冪等キーの形式が無効 (400)
+	if errors.Is(err, domain.ErrInvalidIdempotencyKey) {
+		return http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_IDEMPOTENCY_KEY",
+			Message: err.Error(),
+		}
+	}
+	// 冪等キーを異なる内容のリクエストに再利用 (422)
+	if errors.Is(err, domain.ErrIdempotencyKeyReused) {
+		return http.StatusUnprocessableEntity, ErrorResponse{
+			Code:    "IDEMPOTENCY_KEY_REUSED",
+			Message: err.Error(),
+		}
+	}
+	// 同じ冪等キーのリクエストが処理中 (409)
+	if errors.Is(err, domain.ErrIdempotencyKeyInUse) {
+		return http.StatusConflict, ErrorResponse{
+			Code:    "IDEMPOTENCY_KEY_IN_USE",
+			Message: err.Error(),
+		}
 	}
 
 	// 内部エラー (500)
-	return c.JSON(http.StatusInternalServerError, ErrorResponse{
+	return http.StatusInternalServerError, ErrorResponse{
 		Code:    "INTERNAL_ERROR",
 		Message: "internal server error",
-	})
+	}
 }
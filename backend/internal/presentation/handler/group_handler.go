@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	groupuc "github.com/ryusuke/task_app_layerx/internal/usecase/group"
+)
+
+// GroupHandlerはユーザーグループ管理のHTTPハンドラー
+type GroupHandler struct {
+	groupUseCase *groupuc.GroupUseCase
+}
+
+// NewGroupHandlerで新しいGroupHandlerを作成
+func NewGroupHandler(groupUseCase *groupuc.GroupUseCase) *GroupHandler {
+	return &GroupHandler{
+		groupUseCase: groupUseCase,
+	}
+}
+
+// ListGroupsはグループ一覧を取得
+// GET /groups
+func (h *GroupHandler) ListGroups(c echo.Context) error {
+	resp, err := h.groupUseCase.ListGroups(c.Request().Context())
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	groups := make([]GroupResponse, len(resp))
+	for i, group := range resp {
+		groups[i] = toGroupResponse(group)
+	}
+
+	return c.JSON(http.StatusOK, groups)
+}
+
+// CreateGroupはグループを作成
+// POST /groups
+func (h *GroupHandler) CreateGroup(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	var req CreateGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.groupUseCase.CreateGroup(c.Request().Context(), userID, groupuc.CreateGroupRequest{
+		Name:      req.Name,
+		MemberIDs: req.MemberIDs,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toGroupResponse(resp))
+}
+
+// GetGroupはグループ詳細を取得
+// GET /groups/:id
+func (h *GroupHandler) GetGroup(c echo.Context) error {
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_GROUP_ID",
+			Message: "invalid group id",
+		})
+	}
+
+	resp, err := h.groupUseCase.GetGroup(c.Request().Context(), groupID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toGroupResponse(resp))
+}
+
+// UpdateGroupはグループを更新
+// PATCH /groups/:id
+func (h *GroupHandler) UpdateGroup(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_GROUP_ID",
+			Message: "invalid group id",
+		})
+	}
+
+	var req UpdateGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.groupUseCase.UpdateGroup(c.Request().Context(), userID, groupID, groupuc.UpdateGroupRequest{
+		Name: req.Name,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toGroupResponse(resp))
+}
+
+// DeleteGroupはグループを削除
+// DELETE /groups/:id
+func (h *GroupHandler) DeleteGroup(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_GROUP_ID",
+			Message: "invalid group id",
+		})
+	}
+
+	if err := h.groupUseCase.DeleteGroup(c.Request().Context(), userID, groupID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AddMemberはグループにメンバーを追加
+// POST /groups/:id/members
+func (h *GroupHandler) AddMember(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_GROUP_ID",
+			Message: "invalid group id",
+		})
+	}
+
+	var req AddGroupMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.groupUseCase.AddMember(c.Request().Context(), userID, groupID, req.UserID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toGroupResponse(resp))
+}
+
+// RemoveMemberはグループからメンバーを削除
+// DELETE /groups/:id/members/:userId
+func (h *GroupHandler) RemoveMember(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_GROUP_ID",
+			Message: "invalid group id",
+		})
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_USER_ID",
+			Message: "invalid user id",
+		})
+	}
+
+	if err := h.groupUseCase.RemoveMember(c.Request().Context(), userID, groupID, memberID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// toGroupResponseはUseCaseのGroupResponseをHandlerのGroupResponseに変換
+func toGroupResponse(group *groupuc.GroupResponse) GroupResponse {
+	members := make([]GroupMemberResponse, len(group.Members))
+	for i, member := range group.Members {
+		members[i] = GroupMemberResponse{
+			UserID:  member.UserID,
+			AddedBy: member.AddedBy,
+			AddedAt: member.AddedAt.Format(time.RFC3339),
+		}
+	}
+
+	return GroupResponse{
+		ID:        group.ID,
+		Name:      group.Name,
+		CreatedBy: group.CreatedBy,
+		Members:   members,
+		CreatedAt: group.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: group.UpdatedAt.Format(time.RFC3339),
+	}
+}
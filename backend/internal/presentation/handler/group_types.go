@@ -0,0 +1,34 @@
+package handler
+
+// CreateGroupRequestはグループ作成のリクエスト
+type CreateGroupRequest struct {
+	Name      string  `json:"name" validate:"required"`
+	MemberIDs []int64 `json:"memberIds"`
+}
+
+// UpdateGroupRequestはグループ更新のリクエスト
+type UpdateGroupRequest struct {
+	Name *string `json:"name"`
+}
+
+// AddGroupMemberRequestはグループメンバー追加のリクエスト
+type AddGroupMemberRequest struct {
+	UserID int64 `json:"userId" validate:"required"`
+}
+
+// GroupResponseはグループのレスポンス
+type GroupResponse struct {
+	ID        int64                 `json:"id"`
+	Name      string                `json:"name"`
+	CreatedBy int64                 `json:"createdBy"`
+	Members   []GroupMemberResponse `json:"members"`
+	CreatedAt string                `json:"createdAt"`
+	UpdatedAt string                `json:"updatedAt"`
+}
+
+// GroupMemberResponseはグループメンバーのレスポンス
+type GroupMemberResponse struct {
+	UserID  int64  `json:"userId"`
+	AddedBy int64  `json:"addedBy"`
+	AddedAt string `json:"addedAt"`
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	slauc "github.com/ryusuke/task_app_layerx/internal/usecase/sla"
+)
+
+// SLAHandlerはSLAポリシーのHTTPハンドラー
+type SLAHandler struct {
+	slaUseCase *slauc.SLAUseCase
+}
+
+// NewSLAHandlerで新しいSLAHandlerを作成
+func NewSLAHandler(slaUseCase *slauc.SLAUseCase) *SLAHandler {
+	return &SLAHandler{
+		slaUseCase: slaUseCase,
+	}
+}
+
+// ListPoliciesはSLAポリシー一覧を取得
+// GET /sla-policies
+func (h *SLAHandler) ListPolicies(c echo.Context) error {
+	resp, err := h.slaUseCase.ListPolicies(c.Request().Context())
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	policies := make([]SLAPolicyResponse, len(resp))
+	for i, policy := range resp {
+		policies[i] = SLAPolicyResponse{
+			Priority:              policy.Priority,
+			StartWithinMinutes:    policy.StartWithinMinutes,
+			ResolveWithinMinutes:  policy.ResolveWithinMinutes,
+			EscalateBeforeMinutes: policy.EscalateBeforeMinutes,
+			EscalationAction:      policy.EscalationAction,
+		}
+	}
+
+	return c.JSON(http.StatusOK, policies)
+}
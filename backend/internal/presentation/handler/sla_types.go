@@ -0,0 +1,10 @@
+package handler
+
+// SLAPolicyResponseはSLAポリシーのレスポンス
+type SLAPolicyResponse struct {
+	Priority              int    `json:"priority"`
+	StartWithinMinutes    int    `json:"startWithinMinutes"`
+	ResolveWithinMinutes  int    `json:"resolveWithinMinutes"`
+	EscalateBeforeMinutes int    `json:"escalateBeforeMinutes"`
+	EscalationAction      string `json:"escalationAction"`
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// analyticsDateLayoutは分析の期間指定と日付の形式
+const analyticsDateLayout = "2006-01-02"
+
+// GetLeadTimeは期間内に完了したタスクの作成から完了までの時間の統計を取得
+// GET /analytics/lead-time?from=&to=
+func (h *TaskHandler) GetLeadTime(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	req, errResp := parseAnalyticsRequest(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.GetLeadTime(c.Request().Context(), userID, req)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toDurationStatsResponse(resp))
+}
+
+// GetCycleTimeは期間内に完了したタスクの最初の着手から完了までの時間の統計を取得
+// GET /analytics/cycle-time?from=&to=
+func (h *TaskHandler) GetCycleTime(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	req, errResp := parseAnalyticsRequest(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.GetCycleTime(c.Request().Context(), userID, req)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toDurationStatsResponse(resp))
+}
+
+// GetThroughputは期間内に完了したタスク数を週ごとに取得
+// GET /analytics/throughput?from=&to=
+func (h *TaskHandler) GetThroughput(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	req, errResp := parseAnalyticsRequest(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.GetThroughput(c.Request().Context(), userID, req)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	weeks := make([]WeeklyThroughputResponse, len(resp.Weeks))
+	for i, week := range resp.Weeks {
+		weeks[i] = WeeklyThroughputResponse{
+			WeekStart: week.WeekStart.Format(analyticsDateLayout),
+			Completed: week.Completed,
+		}
+	}
+
+	return c.JSON(http.StatusOK, ThroughputResponse{
+		From:  resp.From.Format(analyticsDateLayout),
+		To:    resp.To.Format(analyticsDateLayout),
+		Weeks: weeks,
+	})
+}
+
+// GetStatusSnapshotsは期間内の日ごとのステータス別タスク数を取得（累積フロー図・バーンダウン用）
+// GET /analytics/status-snapshots?from=&to=
+func (h *TaskHandler) GetStatusSnapshots(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	req, errResp := parseAnalyticsRequest(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.GetStatusSnapshots(c.Request().Context(), userID, req)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	days := make([]StatusSnapshotResponse, len(resp.Days))
+	for i, day := range resp.Days {
+		days[i] = StatusSnapshotResponse{
+			Date:   day.Date.Format(analyticsDateLayout),
+			Counts: day.Counts,
+		}
+	}
+
+	return c.JSON(http.StatusOK, StatusSnapshotsResponse{
+		From: resp.From.Format(analyticsDateLayout),
+		To:   resp.To.Format(analyticsDateLayout),
+		Days: days,
+	})
+}
+
+// parseAnalyticsRequestは分析の期間（from・toの日付）をパース
+func parseAnalyticsRequest(c echo.Context) (taskuc.AnalyticsRequest, *ErrorResponse) {
+	var req taskuc.AnalyticsRequest
+	for _, param := range []struct {
+		name   string
+		target *time.Time
+	}{
+		{"from", &req.From},
+		{"to", &req.To},
+	} {
+		parsed, err := time.Parse(analyticsDateLayout, c.QueryParam(param.name))
+		if err != nil {
+			return taskuc.AnalyticsRequest{}, &ErrorResponse{
+				Code:    "INVALID_DATE_FORMAT",
+				Message: param.name + " must be a date (YYYY-MM-DD)",
+				Details: map[string]interface{}{"field": param.name},
+			}
+		}
+		*param.target = parsed
+	}
+	return req, nil
+}
+
+// toDurationStatsResponseは所要時間の統計を時間単位のレスポンスに変換
+func toDurationStatsResponse(resp *taskuc.DurationStatsResponse) DurationStatsResponse {
+	return DurationStatsResponse{
+		From:         resp.From.Format(analyticsDateLayout),
+		To:           resp.To.Format(analyticsDateLayout),
+		Count:        resp.Count,
+		AverageHours: roundHours(resp.Average),
+		MedianHours:  roundHours(resp.Median),
+		P85Hours:     roundHours(resp.P85),
+	}
+}
+
+// roundHoursは時間を小数点以下2桁の時間数に変換
+func roundHours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
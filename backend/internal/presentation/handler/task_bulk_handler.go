@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// BulkUpdateTasksは複数のタスクに同じ変更（または削除）を一括で適用
+// POST /tasks/bulk
+func (h *TaskHandler) BulkUpdateTasks(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	var req BulkTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	dueDate, dueAllDay, err := parseDueDate(req.DueDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "dueDate must be in ISO8601 format (date-time or date)",
+		})
+	}
+
+	resp, err := h.taskUseCase.BulkUpdateTasks(c.Request().Context(), userID, taskuc.BulkTaskRequest{
+		TaskIDs:           req.TaskIDs,
+		Mode:              taskuc.BulkMode(req.Mode),
+		Status:            req.Status,
+		Priority:          req.Priority,
+		DueDate:           dueDate,
+		DueAllDay:         dueAllDay,
+		AddAssigneeIDs:    req.AddAssigneeIDs,
+		RemoveAssigneeIDs: req.RemoveAssigneeIDs,
+		Delete:            req.Delete,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	results := make([]BulkTaskResult, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = BulkTaskResult{
+			TaskID:  result.TaskID,
+			Success: result.Success,
+		}
+		if result.Err != nil {
+			_, errResp := toErrorResponse(result.Err)
+			results[i].Error = &errResp
+		}
+	}
+
+	// タスクごとの成否は結果に含めるため、一部または全部が失敗した場合も200を返す
+	return c.JSON(http.StatusOK, BulkTaskResponse{
+		Mode:      string(resp.Mode),
+		Applied:   resp.Applied,
+		Succeeded: resp.Succeeded,
+		Failed:    resp.Failed,
+		Results:   results,
+	})
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// taskETagはタスクのバージョンから強いETagを作成する
+func taskETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatchはIf-Matchヘッダーから更新・削除の前提となるバージョンを取得する
+// 未指定または"*"の場合はnil（バージョンを照合しない）を返す
+func parseIfMatch(c echo.Context) (*int64, *ErrorResponse) {
+	value := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	// 発行するETagは強いETagのみのため、弱いETagや複数指定は受け付けない
+	invalid := &ErrorResponse{
+		Code:    "INVALID_IF_MATCH",
+		Message: `If-Match must be a single ETag returned by the server (e.g. "3")`,
+	}
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return nil, invalid
+	}
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return nil, invalid
+	}
+	return &version, nil
+}
+
+// respondTaskはタスクのレスポンスをETag付きで返す
+func respondTask(c echo.Context, status int, task *taskuc.TaskResponse) error {
+	c.Response().Header().Set("ETag", taskETag(task.Version))
+	return c.JSON(status, toTaskResponse(task))
+}
+
+// respondPreconditionFailedはIf-Matchのバージョンが一致しなかった場合に、現在のタスクを412で返す
+// 現在のタスクを取得できない場合（削除済みなど）はそのエラーを返す
+func (h *TaskHandler) respondPreconditionFailed(c echo.Context, userID, taskID int64) error {
+	current, err := h.taskUseCase.GetTask(c.Request().Context(), userID, taskID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+	return respondTask(c, http.StatusPreconditionFailed, current)
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	"github.com/ryusuke/task_app_layerx/pkg/csvutil"
+)
+
+// taskExportWriterはエクスポート形式ごとの書き出し処理
+type taskExportWriter interface {
+	begin() error
+	writeTasks(tasks []ExportTask) error
+	end() error
+}
+
+// ExportTasksは閲覧可能なタスクをCSV・JSON・Markdownでエクスポート
+// GET /tasks/export?format=csv|json|md
+func (h *TaskHandler) ExportTasks(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	res := c.Response()
+	var writer taskExportWriter
+	var contentType string
+	switch format {
+	case "csv":
+		writer = &csvTaskExportWriter{w: csv.NewWriter(res)}
+		contentType = "text/csv; charset=utf-8"
+	case "json":
+		writer = &jsonTaskExportWriter{w: res}
+		contentType = echo.MIMEApplicationJSONCharsetUTF8
+	case "md":
+		writer = &markdownTaskExportWriter{w: res}
+		contentType = "text/markdown; charset=utf-8"
+	default:
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "VALIDATION_ERROR",
+			Message: "format must be csv, json or md",
+			Details: map[string]interface{}{"field": "format"},
+		})
+	}
+
+	// 最初のページを取得できてからヘッダーを書き出す（それまでのエラーは通常のエラーレスポンスで返す）
+	started := false
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+		res.Header().Set(echo.HeaderContentType, contentType)
+		res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="tasks.%s"`, format))
+		res.WriteHeader(http.StatusOK)
+		return writer.begin()
+	}
+
+	err := h.taskUseCase.ExportTasks(c.Request().Context(), userID, func(rows []taskuc.ExportTaskRow) error {
+		if err := start(); err != nil {
+			return err
+		}
+		tasks := make([]ExportTask, len(rows))
+		for i, row := range rows {
+			tasks[i] = toExportTask(row)
+		}
+		if err := writer.writeTasks(tasks); err != nil {
+			return err
+		}
+		res.Flush()
+		return nil
+	})
+	if err != nil {
+		if !started {
+			return HandleError(c, err)
+		}
+		// ストリーミング開始後はステータスを変更できないため、途中で打ち切る
+		log.Printf("failed to export tasks: %v", err)
+		return nil
+	}
+
+	// タスクが0件の場合もヘッダーのみのファイルを返す
+	if err := start(); err != nil {
+		return err
+	}
+	return writer.end()
+}
+
+// toExportTaskはUseCaseのExportTaskRowをExportTaskに変換
+func toExportTask(row taskuc.ExportTaskRow) ExportTask {
+	return ExportTask{
+		ID:          row.ID,
+		Title:       row.Title,
+		Description: row.Description,
+		Status:      row.Status,
+		Priority:    row.Priority,
+		StartDate:   formatTime(row.StartDate),
+		DueDate:     formatDueDate(row.DueDate, row.DueAllDay),
+		Assignees:   row.AssigneeNames,
+		CreatedAt:   row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   row.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// stringOrEmptyはnilの場合に空文字を返す
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// csvTaskExportWriterはCSV形式で書き出す
+// 表計算ソフトで開いたときにタイトルなどが数式として実行されないよう、各セルをエスケープする
+type csvTaskExportWriter struct {
+	w *csv.Writer
+}
+
+func (e *csvTaskExportWriter) begin() error {
+	return e.w.Write([]string{"id", "title", "description", "status", "priority", "start_date", "due_date", "assignees", "created_at", "updated_at"})
+}
+
+func (e *csvTaskExportWriter) writeTasks(tasks []ExportTask) error {
+	for _, task := range tasks {
+		record := []string{
+			strconv.FormatInt(task.ID, 10),
+			task.Title,
+			stringOrEmpty(task.Description),
+			task.Status,
+			strconv.Itoa(task.Priority),
+			stringOrEmpty(task.StartDate),
+			stringOrEmpty(task.DueDate),
+			strings.Join(task.Assignees, ", "),
+			task.CreatedAt,
+			task.UpdatedAt,
+		}
+		for i, cell := range record {
+			record[i] = csvutil.EscapeFormula(cell)
+		}
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvTaskExportWriter) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonTaskExportWriterはJSON配列として書き出す
+type jsonTaskExportWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (e *jsonTaskExportWriter) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonTaskExportWriter) writeTasks(tasks []ExportTask) error {
+	for _, task := range tasks {
+		if e.written {
+			if _, err := io.WriteString(e.w, ","); err != nil {
+				return err
+			}
+		}
+		b, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		if _, err := e.w.Write(b); err != nil {
+			return err
+		}
+		e.written = true
+	}
+	return nil
+}
+
+func (e *jsonTaskExportWriter) end() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
+
+// markdownTaskExportWriterはMarkdownの表として書き出す（週次レポート用）
+type markdownTaskExportWriter struct {
+	w io.Writer
+}
+
+// markdownCellReplacerは表のセルを壊す文字をエスケープする
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func (e *markdownTaskExportWriter) begin() error {
+	_, err := io.WriteString(e.w, "| ID | タイトル | ステータス | 優先度 | 開始日 | 期日 | 担当者 |\n| --- | --- | --- | --- | --- | --- | --- |\n")
+	return err
+}
+
+func (e *markdownTaskExportWriter) writeTasks(tasks []ExportTask) error {
+	for _, task := range tasks {
+		_, err := fmt.Fprintf(e.w, "| %d | %s | %s | %d | %s | %s | %s |\n",
+			task.ID,
+			markdownCellReplacer.Replace(task.Title),
+			task.Status,
+			task.Priority,
+			stringOrEmpty(task.StartDate),
+			stringOrEmpty(task.DueDate),
+			markdownCellReplacer.Replace(strings.Join(task.Assignees, ", ")),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *markdownTaskExportWriter) end() error {
+	return nil
+}
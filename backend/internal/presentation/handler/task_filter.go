@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
+)
+
+// taskSortKeysはクエリパラメータsortの値と並び替え項目の対応
+var taskSortKeys = map[string]domain.TaskSortKey{
+	"createdAt": domain.TaskSortCreatedAt,
+	"dueDate":   domain.TaskSortDueDate,
+	"priority":  domain.TaskSortPriority,
+	"updatedAt": domain.TaskSortUpdatedAt,
+}
+
+// parseTaskFilterはタスク一覧のクエリパラメータを絞り込み条件に変換する
+// 値の形式が不正な場合はレスポンスに使うErrorResponseを返す（値の範囲はTaskFilter.Validateで検証する）
+func parseTaskFilter(c echo.Context) (domain.TaskFilter, *ErrorResponse) {
+	req := TaskFilterRequest{Status: parseStatusParam(c)}
+
+	var errResp *ErrorResponse
+	if req.PriorityMin, errResp = parseIntParam(c, "priorityMin"); errResp != nil {
+		return domain.TaskFilter{}, errResp
+	}
+	if req.PriorityMax, errResp = parseIntParam(c, "priorityMax"); errResp != nil {
+		return domain.TaskFilter{}, errResp
+	}
+
+	for name, dst := range map[string]**string{
+		"dueFrom":     &req.DueFrom,
+		"dueTo":       &req.DueTo,
+		"createdFrom": &req.CreatedFrom,
+		"createdTo":   &req.CreatedTo,
+		"updatedFrom": &req.UpdatedFrom,
+		"updatedTo":   &req.UpdatedTo,
+	} {
+		if value := c.QueryParam(name); value != "" {
+			*dst = &value
+		}
+	}
+
+	if value := c.QueryParam("overdue"); value != "" {
+		overdue, err := strconv.ParseBool(value)
+		if err != nil {
+			return domain.TaskFilter{}, invalidParam("overdue", "overdue must be true or false")
+		}
+		req.Overdue = &overdue
+	}
+
+	req.Role = c.QueryParam("role")
+
+	if value := c.QueryParam("assigneeId"); value != "" {
+		assigneeID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return domain.TaskFilter{}, invalidParam("assigneeId", "assigneeId must be an integer")
+		}
+		req.AssigneeID = &assigneeID
+	}
+
+	req.Sort = c.QueryParam("sort")
+	req.Order = c.QueryParam("order")
+
+	return toTaskFilter(req)
+}
+
+// toTaskFilterはTaskFilterRequestを絞り込み条件に変換する（クエリパラメータと保存済みビューで共通）
+func toTaskFilter(req TaskFilterRequest) (domain.TaskFilter, *ErrorResponse) {
+	var filter domain.TaskFilter
+
+	for _, status := range req.Status {
+		filter.Statuses = append(filter.Statuses, domain.TaskStatus(strings.ToUpper(status)))
+	}
+	filter.PriorityMin = req.PriorityMin
+	filter.PriorityMax = req.PriorityMax
+
+	ranges := []struct {
+		value *string
+		dst   **time.Time
+		name  string
+		end   bool
+	}{
+		{req.DueFrom, &filter.DueFrom, "dueFrom", false},
+		{req.DueTo, &filter.DueTo, "dueTo", true},
+		{req.CreatedFrom, &filter.CreatedFrom, "createdFrom", false},
+		{req.CreatedTo, &filter.CreatedTo, "createdTo", true},
+		{req.UpdatedFrom, &filter.UpdatedFrom, "updatedFrom", false},
+		{req.UpdatedTo, &filter.UpdatedTo, "updatedTo", true},
+	}
+	for _, r := range ranges {
+		parsed, errResp := parseRangeBound(r.value, r.name, r.end)
+		if errResp != nil {
+			return filter, errResp
+		}
+		*r.dst = parsed
+	}
+
+	filter.Overdue = req.Overdue
+	filter.Role = domain.TaskRole(strings.ToUpper(req.Role))
+	filter.AssigneeID = req.AssigneeID
+
+	if req.Sort != "" {
+		sortKey, ok := taskSortKeys[req.Sort]
+		if !ok {
+			return filter, invalidParam("sort", domain.ErrInvalidSortKey.Error())
+		}
+		filter.SortKey = sortKey
+	}
+	filter.SortDirection = domain.SortDirection(strings.ToUpper(req.Order))
+
+	return filter, nil
+}
+
+// toTaskFilterRequestは絞り込み条件をレスポンス用のTaskFilterRequestに変換する
+// 期間の終了は含まない時刻（RFC3339）で返す
+func toTaskFilterRequest(filter domain.TaskFilter) TaskFilterRequest {
+	req := TaskFilterRequest{
+		PriorityMin: filter.PriorityMin,
+		PriorityMax: filter.PriorityMax,
+		DueFrom:     formatTime(filter.DueFrom),
+		DueTo:       formatTime(filter.DueTo),
+		CreatedFrom: formatTime(filter.CreatedFrom),
+		CreatedTo:   formatTime(filter.CreatedTo),
+		UpdatedFrom: formatTime(filter.UpdatedFrom),
+		UpdatedTo:   formatTime(filter.UpdatedTo),
+		Overdue:     filter.Overdue,
+		Role:        strings.ToLower(string(filter.Role)),
+		AssigneeID:  filter.AssigneeID,
+		Order:       strings.ToLower(string(filter.SortDirection)),
+	}
+	for _, status := range filter.Statuses {
+		req.Status = append(req.Status, string(status))
+	}
+	for name, sortKey := range taskSortKeys {
+		if sortKey == filter.SortKey {
+			req.Sort = name
+		}
+	}
+	return req
+}
+
+// parseStatusParamはクエリパラメータstatusをパースする（カンマ区切りでも、複数回の指定でもよい）
+func parseStatusParam(c echo.Context) []string {
+	var statuses []string
+	for _, value := range c.QueryParams()["status"] {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses
+}
+
+// parseIntParamは整数のクエリパラメータをパースする（未指定の場合はnil）
+func parseIntParam(c echo.Context, name string) (*int, *ErrorResponse) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, invalidParam(name, name+" must be an integer")
+	}
+	return &parsed, nil
+}
+
+// parseRangeBoundは期間の境界をパースする（未指定の場合はnil）
+// 日付のみ（UTCの日付として扱う）の終端はその日を含めるため翌日の0時にする
+func parseRangeBound(value *string, name string, end bool) (*time.Time, *ErrorResponse) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	parsed, dateOnly, err := parseDueDate(value)
+	if err != nil {
+		return nil, &ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: name + " must be in ISO8601 format (date-time or date)",
+			Details: map[string]interface{}{"field": name},
+		}
+	}
+	if end && dateOnly {
+		next := parsed.AddDate(0, 0, 1)
+		parsed = &next
+	}
+	return parsed, nil
+}
+
+// invalidParamはクエリパラメータの形式エラーのレスポンスを作成する
+func invalidParam(field, message string) *ErrorResponse {
+	return &ErrorResponse{
+		Code:    "VALIDATION_ERROR",
+		Message: message,
+		Details: map[string]interface{}{"field": field},
+	}
+}
+
+// taskCursorPayloadはカーソルに署名して埋め込む内容
+type taskCursorPayload struct {
+	SortKey       domain.TaskSortKey   `json:"s"`
+	SortDirection domain.SortDirection `json:"d"`
+	Time          *time.Time           `json:"t,omitempty"`
+	Priority      int                  `json:"p,omitempty"`
+	ID            int64                `json:"id"`
+}
+
+// encodeTaskCursorはカーソルを署名付きの文字列に変換する
+func encodeTaskCursor(signer cursor.Signer, c *domain.TaskCursor) (string, error) {
+	return signer.Encode(taskCursorPayload{
+		SortKey:       c.SortKey,
+		SortDirection: c.SortDirection,
+		Time:          c.Time,
+		Priority:      c.Priority,
+		ID:            c.ID,
+	})
+}
+
+// decodeTaskCursorは署名付きの文字列を検証してカーソルに変換する（未指定の場合はnil）
+func decodeTaskCursor(signer cursor.Signer, value string) (*domain.TaskCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var payload taskCursorPayload
+	if err := signer.Decode(value, &payload); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	return &domain.TaskCursor{
+		SortKey:       payload.SortKey,
+		SortDirection: payload.SortDirection,
+		Time:          payload.Time,
+		Priority:      payload.Priority,
+		ID:            payload.ID,
+	}, nil
+}
+
+// toTaskListResponseはタスク一覧のレスポンスを作成し、次のページのカーソルを署名付きの文字列にする
+func toTaskListResponse(signer cursor.Signer, resp *taskuc.ListTasksResponse) (TaskListResponse, error) {
+	tasks := make([]TaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = toTaskResponse(task)
+	}
+
+	list := TaskListResponse{Items: tasks, Total: resp.Total}
+	if resp.NextCursor != nil {
+		next, err := encodeTaskCursor(signer, resp.NextCursor)
+		if err != nil {
+			return TaskListResponse{}, err
+		}
+		list.NextCursor = &next
+	}
+	return list, nil
+}
@@ -1,39 +1,56 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
 	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
 	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
 )
 
 // TaskHandlerはタスク管理のHTTPハンドラー
 type TaskHandler struct {
-	taskUseCase *taskuc.TaskUseCase
+	taskUseCase  *taskuc.TaskUseCase
+	cursorSigner cursor.Signer
 }
 
 // NewTaskHandlerで新しいTaskHandlerを作成
-func NewTaskHandler(taskUseCase *taskuc.TaskUseCase) *TaskHandler {
+func NewTaskHandler(taskUseCase *taskuc.TaskUseCase, cursorSigner cursor.Signer) *TaskHandler {
 	return &TaskHandler{
-		taskUseCase: taskUseCase,
+		taskUseCase:  taskUseCase,
+		cursorSigner: cursorSigner,
 	}
 }
 
 // ListTasksはタスク一覧を取得
-// GET /tasks
+// GET /tasks?status=&priorityMin=&priorityMax=&dueFrom=&dueTo=&overdue=&role=&assigneeId=&createdFrom=&createdTo=&updatedFrom=&updatedTo=&sort=&order=&limit=&cursor=&includeTotal=
 func (h *TaskHandler) ListTasks(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 
 	// クエリパラメータを取得
 	limit, _ := strconv.Atoi(c.QueryParam("limit"))
-	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+	includeTotal, _ := strconv.ParseBool(c.QueryParam("includeTotal"))
+
+	filter, errResp := parseTaskFilter(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	after, err := decodeTaskCursor(h.cursorSigner, c.QueryParam("cursor"))
+	if err != nil {
+		return HandleError(c, err)
+	}
 
 	req := taskuc.ListTasksRequest{
-		Limit:  limit,
-		Offset: offset,
+		Filter:       filter,
+		After:        after,
+		Limit:        limit,
+		IncludeTotal: includeTotal,
 	}
 
 	resp, err := h.taskUseCase.ListTasks(c.Request().Context(), userID, req)
@@ -42,12 +59,12 @@ func (h *TaskHandler) ListTasks(c echo.Context) error {
 	}
 
 	// レスポンス変換
-	tasks := make([]TaskResponse, len(resp))
-	for i, task := range resp {
-		tasks[i] = toTaskResponse(task)
+	list, err := toTaskListResponse(h.cursorSigner, resp)
+	if err != nil {
+		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, tasks)
+	return c.JSON(http.StatusOK, list)
 }
 
 // CreateTaskはタスクを作成
@@ -63,24 +80,31 @@ func (h *TaskHandler) CreateTask(c echo.Context) error {
 		})
 	}
 
-	var dueDate *time.Time
-	if req.DueDate != nil {
-		parsed, err := time.Parse(time.RFC3339, *req.DueDate)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Code:    "INVALID_DATE_FORMAT",
-				Message: "dueDate must be in ISO8601 format",
-			})
-		}
-		dueDate = &parsed
+	dueDate, dueAllDay, err := parseDueDate(req.DueDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "dueDate must be in ISO8601 format (date-time or date)",
+		})
+	}
+
+	startDate, err := parseStartDate(req.StartDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "startDate must be in ISO8601 format (date-time or date)",
+		})
 	}
 
 	usecaseReq := taskuc.CreateTaskRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		DueDate:     dueDate,
-		Priority:    req.Priority,
-		AssigneeIDs: req.AssigneeIDs,
+		Title:            req.Title,
+		Description:      req.Description,
+		StartDate:        startDate,
+		DueDate:          dueDate,
+		DueAllDay:        dueAllDay,
+		Priority:         req.Priority,
+		AssigneeIDs:      req.AssigneeIDs,
+		AssigneeGroupIDs: req.AssigneeGroupIDs,
 	}
 
 	resp, err := h.taskUseCase.CreateTask(c.Request().Context(), userID, usecaseReq)
@@ -88,7 +112,7 @@ func (h *TaskHandler) CreateTask(c echo.Context) error {
 		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusCreated, toTaskResponse(resp))
+	return respondTask(c, http.StatusCreated, resp)
 }
 
 // GetTaskはタスク詳細を取得
@@ -109,11 +133,12 @@ func (h *TaskHandler) GetTask(c echo.Context) error {
 		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, toTaskResponse(resp))
+	return respondTask(c, http.StatusOK, resp)
 }
 
 // UpdateTaskはタスクを更新
 // PATCH /tasks/:id
+// If-Matchを指定した場合、ETagが一致しなければ412と現在のタスクを返す
 func (h *TaskHandler) UpdateTask(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 
@@ -125,6 +150,11 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 		})
 	}
 
+	expectedVersion, errResp := parseIfMatch(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
 	var req UpdateTaskRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -134,37 +164,50 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 	}
 
 	// DueDateをパース
-	var dueDate *time.Time
-	if req.DueDate != nil {
-		parsed, err := time.Parse(time.RFC3339, *req.DueDate)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Code:    "INVALID_DATE_FORMAT",
-				Message: "dueDate must be in ISO8601 format",
-			})
-		}
-		dueDate = &parsed
+	dueDate, dueAllDay, err := parseDueDate(req.DueDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "dueDate must be in ISO8601 format (date-time or date)",
+		})
+	}
+
+	startDate, err := parseStartDate(req.StartDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "startDate must be in ISO8601 format (date-time or date)",
+		})
 	}
 
 	usecaseReq := taskuc.UpdateTaskRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		DueDate:     dueDate,
-		Status:      req.Status,
-		Priority:    req.Priority,
-		AssigneeIDs: req.AssigneeIDs,
+		Title:            req.Title,
+		Description:      req.Description,
+		StartDate:        startDate,
+		DueDate:          dueDate,
+		DueAllDay:        dueAllDay,
+		Status:           req.Status,
+		Priority:         req.Priority,
+		AssigneeIDs:      req.AssigneeIDs,
+		AssigneeGroupIDs: req.AssigneeGroupIDs,
+		ExpectedVersion:  expectedVersion,
 	}
 
 	resp, err := h.taskUseCase.UpdateTask(c.Request().Context(), userID, taskID, usecaseReq)
+	// 412はIf-Matchを指定したリクエストにのみ返す
+	if expectedVersion != nil && errors.Is(err, domain.ErrTaskVersionMismatch) {
+		return h.respondPreconditionFailed(c, userID, taskID)
+	}
 	if err != nil {
 		return HandleError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, toTaskResponse(resp))
+	return respondTask(c, http.StatusOK, resp)
 }
 
 // DeleteTaskはタスクを削除
 // DELETE /tasks/:id
+// If-Matchを指定した場合、ETagが一致しなければ412と現在のタスクを返す
 func (h *TaskHandler) DeleteTask(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 
@@ -176,7 +219,17 @@ func (h *TaskHandler) DeleteTask(c echo.Context) error {
 		})
 	}
 
-	if err := h.taskUseCase.DeleteTask(c.Request().Context(), userID, taskID); err != nil {
+	expectedVersion, errResp := parseIfMatch(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	err = h.taskUseCase.DeleteTask(c.Request().Context(), userID, taskID, expectedVersion)
+	// 412はIf-Matchを指定したリクエストにのみ返す
+	if expectedVersion != nil && errors.Is(err, domain.ErrTaskVersionMismatch) {
+		return h.respondPreconditionFailed(c, userID, taskID)
+	}
+	if err != nil {
 		return HandleError(c, err)
 	}
 
@@ -184,14 +237,81 @@ func (h *TaskHandler) DeleteTask(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
-// toTaskResponseはUseCaseのTaskResponseをHandlerのTaskResponseに変換
-func toTaskResponse(task *taskuc.TaskResponse) TaskResponse {
-	var dueDate *string
-	if task.DueDate != nil {
-		formatted := task.DueDate.Format(time.RFC3339)
-		dueDate = &formatted
+// allDayDateLayoutは終日の期日（日付のみ）のフォーマット
+const allDayDateLayout = "2006-01-02"
+
+// parseDueDateは期日をパースする
+// 日付のみ（2006-01-02）の場合は終日の期日、RFC3339の場合は時刻まで指定した締め切りとして扱う
+func parseDueDate(value *string) (*time.Time, bool, error) {
+	if value == nil {
+		return nil, false, nil
+	}
+	if parsed, err := time.Parse(allDayDateLayout, *value); err == nil {
+		return &parsed, true, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, false, err
+	}
+	return &parsed, false, nil
+}
+
+// parseStartDateは開始日をパースする
+// 日付のみ（2006-01-02）の場合はその日の0時（UTC）として扱う
+func parseStartDate(value *string) (*time.Time, error) {
+	parsed, _, err := parseDueDate(value)
+	return parsed, err
+}
+
+// formatTimeは時刻をRFC3339形式の文字列に変換する
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+// formatDueDateは期日を文字列に変換する（終日の期日は日付のみ）
+func formatDueDate(dueDate *time.Time, allDay bool) *string {
+	if dueDate == nil {
+		return nil
+	}
+	layout := time.RFC3339
+	if allDay {
+		layout = allDayDateLayout
+	}
+	formatted := dueDate.Format(layout)
+	return &formatted
+}
+
+// durationMinutesは経過時間を分単位に変換する
+func durationMinutes(d *time.Duration) *int {
+	if d == nil {
+		return nil
 	}
+	minutes := int(*d / time.Minute)
+	return &minutes
+}
 
+// toSLAResponseはUseCaseのSLAResponseをHandlerのSLAResponseに変換
+func toSLAResponse(sla *taskuc.SLAResponse) *SLAResponse {
+	if sla == nil {
+		return nil
+	}
+	return &SLAResponse{
+		StartDeadline:      sla.StartDeadline.Format(time.RFC3339),
+		ResolveDeadline:    sla.ResolveDeadline.Format(time.RFC3339),
+		TimeToStartMinutes: durationMinutes(sla.TimeToStart),
+		TimeToDoneMinutes:  durationMinutes(sla.TimeToDone),
+		StartBreached:      sla.StartBreached,
+		ResolveBreached:    sla.ResolveBreached,
+		EscalatedAt:        formatTime(sla.EscalatedAt),
+	}
+}
+
+// toTaskResponseはUseCaseのTaskResponseをHandlerのTaskResponseに変換
+func toTaskResponse(task *taskuc.TaskResponse) TaskResponse {
 	assignees := make([]AssigneeResponse, len(task.Assignees))
 	for i, assignee := range task.Assignees {
 		assignees[i] = AssigneeResponse{
@@ -201,16 +321,35 @@ func toTaskResponse(task *taskuc.TaskResponse) TaskResponse {
 		}
 	}
 
+	groupAssignees := make([]GroupAssigneeResponse, len(task.GroupAssignees))
+	for i, groupAssignee := range task.GroupAssignees {
+		groupAssignees[i] = GroupAssigneeResponse{
+			GroupID:    groupAssignee.GroupID,
+			AssignedBy: groupAssignee.AssignedBy,
+			AssignedAt: groupAssignee.AssignedAt.Format(time.RFC3339),
+		}
+	}
+
 	return TaskResponse{
-		ID:          task.ID,
-		OwnerID:     task.OwnerID,
-		Title:       task.Title,
-		Description: task.Description,
-		DueDate:     dueDate,
-		Status:      task.Status,
-		Priority:    task.Priority,
-		Assignees:   assignees,
-		CreatedAt:   task.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   task.UpdatedAt.Format(time.RFC3339),
+		ID:              task.ID,
+		OwnerID:         task.OwnerID,
+		Title:           task.Title,
+		Description:     task.Description,
+		DescriptionHTML: task.DescriptionHTML,
+		StartDate:       formatTime(task.StartDate),
+		DueDate:         formatDueDate(task.DueDate, task.DueAllDay),
+		DueAllDay:       task.DueAllDay,
+		Overdue:         task.Overdue,
+		Status:          task.Status,
+		Priority:        task.Priority,
+		StartedAt:       formatTime(task.StartedAt),
+		CompletedAt:     formatTime(task.CompletedAt),
+		SLA:             toSLAResponse(task.SLA),
+		SLABreached:     task.SLA != nil && task.SLA.Breached,
+		Assignees:       assignees,
+		GroupAssignees:  groupAssignees,
+		Version:         task.Version,
+		CreatedAt:       task.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       task.UpdatedAt.Format(time.RFC3339),
 	}
 }
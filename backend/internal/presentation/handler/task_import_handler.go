@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	"github.com/ryusuke/task_app_layerx/pkg/csvutil"
+)
+
+// maxImportBodySizeはインポートで受け付けるリクエストボディの最大サイズ（5MB）
+const maxImportBodySize = 5 << 20
+
+// importColumnsはCSVのヘッダー名（小文字・記号除去後）と項目の対応
+var importColumns = map[string]string{
+	"title":         "title",
+	"description":   "description",
+	"duedate":       "dueDate",
+	"due":           "dueDate",
+	"priority":      "priority",
+	"status":        "status",
+	"assigneeemail": "assigneeEmail",
+	"assignee":      "assigneeEmail",
+}
+
+// ImportTasksはCSVまたはJSONからタスクを一括登録
+// POST /tasks/import?dryRun=true
+func (h *TaskHandler) ImportTasks(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dryRun"))
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+
+	// 上限を超えたボディは途中で切り詰めず、取り込み前に拒否する（最後の行が欠けたまま登録されないように）
+	data, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxImportBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return c.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+				Code:    "PAYLOAD_TOO_LARGE",
+				Message: "request body must be 5MB or smaller",
+			})
+		}
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "failed to read request body",
+		})
+	}
+	body := bytes.NewReader(data)
+
+	var rows []taskuc.ImportTaskRow
+	switch mediaType {
+	case "text/csv":
+		rows, err = parseImportCSV(body)
+	case echo.MIMEApplicationJSON:
+		rows, err = parseImportJSON(body)
+	default:
+		return c.JSON(http.StatusUnsupportedMediaType, ErrorResponse{
+			Code:    "UNSUPPORTED_MEDIA_TYPE",
+			Message: "Content-Type must be text/csv or application/json",
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+	}
+
+	resp, err := h.taskUseCase.ImportTasks(c.Request().Context(), userID, taskuc.ImportTasksRequest{
+		Rows:   rows,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	rowErrors := make([]ImportRowError, len(resp.Errors))
+	for i, rowErr := range resp.Errors {
+		rowErrors[i] = ImportRowError{
+			Line:    rowErr.Line,
+			Field:   rowErr.Field,
+			Message: rowErr.Message,
+		}
+	}
+
+	status := http.StatusCreated
+	if resp.DryRun {
+		status = http.StatusOK
+	}
+	// 1行でもエラーがある場合は何も登録しない
+	if len(rowErrors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	return c.JSON(status, ImportTasksResponse{
+		DryRun:   resp.DryRun,
+		Total:    resp.Total,
+		Valid:    resp.Valid,
+		Imported: resp.Imported,
+		TaskIDs:  resp.TaskIDs,
+		Errors:   rowErrors,
+	})
+}
+
+// parseImportCSVはヘッダー付きCSVを読み込む（行番号はヘッダーを1行目として数える）
+func parseImportCSV(r io.Reader) ([]taskuc.ImportTaskRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("csv header is required")
+	}
+
+	columns := make([]string, len(header))
+	hasTitle := false
+	for i, name := range header {
+		// Excelで保存したCSVの先頭に付くBOMを除去する
+		key := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		key = strings.NewReplacer("_", "", "-", "", " ", "").Replace(key)
+		columns[i] = importColumns[key]
+		if columns[i] == "title" {
+			hasTitle = true
+		}
+	}
+	if !hasTitle {
+		return nil, errors.New("csv must have a title column")
+	}
+
+	var rows []taskuc.ImportTaskRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("invalid csv: " + err.Error())
+		}
+
+		row := taskuc.ImportTaskRow{Line: line}
+		for i, value := range record {
+			if i >= len(columns) {
+				break
+			}
+			// エクスポート時に数式対策で付けた「'」を取り除く
+			value = csvutil.UnescapeFormula(value)
+			switch columns[i] {
+			case "title":
+				row.Title = value
+			case "description":
+				row.Description = value
+			case "dueDate":
+				row.DueDate = value
+			case "priority":
+				row.Priority = value
+			case "status":
+				row.Status = value
+			case "assigneeEmail":
+				row.AssigneeEmail = value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseImportJSONはタスクの配列を読み込む（行番号は配列の1始まりの添字）
+func parseImportJSON(r io.Reader) ([]taskuc.ImportTaskRow, error) {
+	var items []ImportTaskRow
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, errors.New("request body must be an array of tasks")
+	}
+
+	rows := make([]taskuc.ImportTaskRow, len(items))
+	for i, item := range items {
+		rows[i] = taskuc.ImportTaskRow{
+			Line:          i + 1,
+			Title:         item.Title,
+			Description:   item.Description,
+			DueDate:       item.DueDate,
+			Status:        item.Status,
+			AssigneeEmail: item.AssigneeEmail,
+		}
+		if item.Priority != nil {
+			rows[i].Priority = strconv.Itoa(*item.Priority)
+		}
+	}
+
+	return rows, nil
+}
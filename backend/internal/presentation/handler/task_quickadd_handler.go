@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// QuickAddTaskは1行の入力を解析してタスクを作成
+// POST /tasks/quick?dryRun=true
+// dryRunの場合は解析結果のみ返し、タスクを作成しない（入力中のプレビュー用）
+func (h *TaskHandler) QuickAddTask(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dryRun"))
+
+	var req QuickAddRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.taskUseCase.QuickAddTask(c.Request().Context(), userID, taskuc.QuickAddRequest{
+		Input:  req.Input,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	assignees := make([]MentionResponse, len(resp.Parsed.Assignees))
+	for i, mention := range resp.Parsed.Assignees {
+		assignees[i] = MentionResponse{Mention: mention.Mention, UserID: mention.UserID}
+	}
+	labels := resp.Parsed.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	body := QuickAddResponse{
+		Parsed: QuickAddParsedResponse{
+			Title:     resp.Parsed.Title,
+			DueDate:   formatDueDate(resp.Parsed.DueDate, resp.Parsed.DueAllDay),
+			DueAllDay: resp.Parsed.DueAllDay,
+			Priority:  resp.Parsed.Priority,
+			Assignees: assignees,
+			Labels:    labels,
+		},
+	}
+
+	if resp.Task == nil {
+		return c.JSON(http.StatusOK, body)
+	}
+
+	task := toTaskResponse(resp.Task)
+	body.Task = &task
+	c.Response().Header().Set("ETag", taskETag(resp.Task.Version))
+	return c.JSON(http.StatusCreated, body)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// GetWorkloadは閲覧可能なタスクを直接アサインされたユーザーごとに集計した負荷レポートを取得
+// GET /reports/workload?status=&dueFrom=&dueTo=
+func (h *TaskHandler) GetWorkload(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	req := TaskFilterRequest{Status: parseStatusParam(c)}
+	if value := c.QueryParam("dueFrom"); value != "" {
+		req.DueFrom = &value
+	}
+	if value := c.QueryParam("dueTo"); value != "" {
+		req.DueTo = &value
+	}
+	filter, errResp := toTaskFilter(req)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.GetWorkload(c.Request().Context(), userID, taskuc.WorkloadRequest{Filter: filter})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	users := make([]WorkloadUserResponse, len(resp.Users))
+	for i, user := range resp.Users {
+		users[i] = WorkloadUserResponse{
+			UserID:     user.UserID,
+			Name:       user.Name,
+			Email:      user.Email,
+			Total:      user.Total,
+			ByPriority: user.ByPriority,
+			Overdue:    user.Overdue,
+		}
+	}
+
+	return c.JSON(http.StatusOK, WorkloadResponse{Users: users})
+}
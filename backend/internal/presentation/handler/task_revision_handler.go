@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// ListRevisionsはタスクのリビジョン一覧を新しい順に取得
+// GET /tasks/:id/revisions
+func (h *TaskHandler) ListRevisions(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidTaskID(c)
+	}
+
+	resp, err := h.taskUseCase.ListRevisions(c.Request().Context(), userID, taskID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	revisions := make([]RevisionResponse, len(resp))
+	for i, revision := range resp {
+		revisions[i] = toRevisionResponse(revision)
+	}
+
+	return c.JSON(http.StatusOK, revisions)
+}
+
+// GetRevisionはタスクの指定したリビジョンを取得
+// GET /tasks/:id/revisions/:rev
+func (h *TaskHandler) GetRevision(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidTaskID(c)
+	}
+	revision, err := strconv.ParseInt(c.Param("rev"), 10, 64)
+	if err != nil {
+		return invalidRevision(c, "rev")
+	}
+
+	resp, err := h.taskUseCase.GetRevision(c.Request().Context(), userID, taskID, revision)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toRevisionResponse(resp))
+}
+
+// DiffRevisionsはリビジョンfromからtoへの項目ごとの変更を取得
+// GET /tasks/:id/revisions/diff?from=&to=
+func (h *TaskHandler) DiffRevisions(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidTaskID(c)
+	}
+	from, err := strconv.ParseInt(c.QueryParam("from"), 10, 64)
+	if err != nil {
+		return invalidRevision(c, "from")
+	}
+	to, err := strconv.ParseInt(c.QueryParam("to"), 10, 64)
+	if err != nil {
+		return invalidRevision(c, "to")
+	}
+
+	resp, err := h.taskUseCase.DiffRevisions(c.Request().Context(), userID, taskID, from, to)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	changes := make([]FieldChangeResponse, len(resp.Changes))
+	for i, change := range resp.Changes {
+		changes[i] = FieldChangeResponse{
+			Field: change.Field,
+			From:  formatFieldValue(change.From),
+			To:    formatFieldValue(change.To),
+		}
+	}
+
+	return c.JSON(http.StatusOK, RevisionDiffResponse{From: resp.From, To: resp.To, Changes: changes})
+}
+
+// RevertTaskはタスクを指定したリビジョンの内容に戻す（タスク更新と同じ権限・バリデーション）
+// POST /tasks/:id/revisions/:rev/revert
+// If-Matchを指定した場合、ETagが一致しなければ412と現在のタスクを返す
+func (h *TaskHandler) RevertTask(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidTaskID(c)
+	}
+	revision, err := strconv.ParseInt(c.Param("rev"), 10, 64)
+	if err != nil {
+		return invalidRevision(c, "rev")
+	}
+
+	expectedVersion, errResp := parseIfMatch(c)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.taskUseCase.RevertTask(c.Request().Context(), userID, taskID, revision, expectedVersion)
+	// 412はIf-Matchを指定したリクエストにのみ返す
+	if expectedVersion != nil && errors.Is(err, domain.ErrTaskVersionMismatch) {
+		return h.respondPreconditionFailed(c, userID, taskID)
+	}
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return respondTask(c, http.StatusOK, resp)
+}
+
+// invalidTaskIDはタスクIDの形式エラーのレスポンスを返す
+func invalidTaskID(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, ErrorResponse{
+		Code:    "INVALID_TASK_ID",
+		Message: "invalid task id",
+	})
+}
+
+// invalidRevisionはリビジョン番号の形式エラーのレスポンスを返す
+func invalidRevision(c echo.Context, field string) error {
+	return c.JSON(http.StatusBadRequest, ErrorResponse{
+		Code:    "INVALID_REVISION",
+		Message: field + " must be a revision number",
+		Details: map[string]interface{}{"field": field},
+	})
+}
+
+// toRevisionResponseはUseCaseのRevisionResponseをHandlerのRevisionResponseに変換
+func toRevisionResponse(revision *taskuc.RevisionResponse) RevisionResponse {
+	return RevisionResponse{
+		Revision:         revision.Revision,
+		Title:            revision.Title,
+		Description:      revision.Description,
+		StartDate:        formatTime(revision.StartDate),
+		DueDate:          formatDueDate(revision.DueDate, revision.DueAllDay),
+		Status:           revision.Status,
+		Priority:         revision.Priority,
+		AssigneeIDs:      revision.AssigneeIDs,
+		AssigneeGroupIDs: revision.AssigneeGroupIDs,
+		ChangedBy:        revision.ChangedBy,
+		CreatedAt:        revision.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// formatFieldValueは差分の値をRevisionResponseの同名の項目と同じ形式に変換する
+func formatFieldValue(value any) any {
+	switch value := value.(type) {
+	case *time.Time:
+		return formatTime(value)
+	case domain.TaskDueDate:
+		return formatDueDate(value.Date, value.AllDay)
+	case domain.TaskStatus:
+		return string(value)
+	default:
+		return value
+	}
+}
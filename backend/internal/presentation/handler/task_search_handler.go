@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// SearchTasksは閲覧可能なタスクをタイトル・説明で全文検索
+// GET /tasks/search?q=&limit=
+func (h *TaskHandler) SearchTasks(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	req := taskuc.SearchTasksRequest{
+		Query: c.QueryParam("q"),
+		Limit: limit,
+	}
+
+	resp, err := h.taskUseCase.SearchTasks(c.Request().Context(), userID, req)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	results := make([]SearchResultResponse, len(resp))
+	for i, result := range resp {
+		results[i] = SearchResultResponse{
+			Task:  toTaskResponse(result.Task),
+			Score: result.Score,
+			Highlights: SearchHighlights{
+				Title:       result.TitleHighlight,
+				Description: result.DescriptionHighlight,
+			},
+		}
+	}
+
+	return c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// ListSharesはタスクの共有設定一覧を取得
+// GET /tasks/:id/shares
+func (h *TaskHandler) ListShares(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	resp, err := h.taskUseCase.ListShares(c.Request().Context(), userID, taskID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	shares := make([]ShareResponse, len(resp))
+	for i, share := range resp {
+		shares[i] = toShareResponse(share)
+	}
+
+	return c.JSON(http.StatusOK, shares)
+}
+
+// ShareTaskはタスクをユーザーと共有する（共有済みの場合は権限を更新）
+// PUT /tasks/:id/shares/:userId
+func (h *TaskHandler) ShareTask(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	targetUserID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_USER_ID",
+			Message: "invalid user id",
+		})
+	}
+
+	var req ShareTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.taskUseCase.ShareTask(c.Request().Context(), userID, taskID, taskuc.ShareTaskRequest{
+		UserID:     targetUserID,
+		Permission: req.Permission,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toShareResponse(*resp))
+}
+
+// RevokeShareはタスクの共有を解除
+// DELETE /tasks/:id/shares/:userId
+func (h *TaskHandler) RevokeShare(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	targetUserID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_USER_ID",
+			Message: "invalid user id",
+		})
+	}
+
+	if err := h.taskUseCase.RevokeShare(c.Request().Context(), userID, taskID, targetUserID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// toShareResponseはUseCaseのShareResponseをHandlerのShareResponseに変換
+func toShareResponse(share taskuc.ShareResponse) ShareResponse {
+	return ShareResponse{
+		UserID:     share.UserID,
+		Permission: share.Permission,
+		SharedBy:   share.SharedBy,
+		SharedAt:   share.SharedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+)
+
+// GetStatsは閲覧可能なタスクのステータス・優先度ごとの件数などを取得（ダッシュボード用）
+// GET /stats
+func (h *TaskHandler) GetStats(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	resp, err := h.taskUseCase.GetStats(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, StatsResponse{
+		Total:       resp.Total,
+		ByStatus:    resp.ByStatus,
+		ByPriority:  resp.ByPriority,
+		Overdue:     resp.Overdue,
+		DueThisWeek: resp.DueThisWeek,
+		Owned:       resp.Owned,
+		Assigned:    resp.Assigned,
+	})
+}
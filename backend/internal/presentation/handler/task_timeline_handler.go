@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// GetTimelineは期間と日程が重なるタスクを依存関係とともに取得（ガントチャート用）
+// GET /timeline?from=&to=
+func (h *TaskHandler) GetTimeline(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	from, _, err := parseTimelineBound(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "from must be in ISO8601 format (date-time or date)",
+			Details: map[string]interface{}{"field": "from"},
+		})
+	}
+	to, dateOnly, err := parseTimelineBound(c.QueryParam("to"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_DATE_FORMAT",
+			Message: "to must be in ISO8601 format (date-time or date)",
+			Details: map[string]interface{}{"field": "to"},
+		})
+	}
+	// 日付のみの場合はその日を含める
+	if dateOnly {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	resp, err := h.taskUseCase.GetTimeline(c.Request().Context(), userID, taskuc.TimelineRequest{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	tasks := make([]TimelineTaskResponse, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = TimelineTaskResponse{
+			ID:        task.ID,
+			Title:     task.Title,
+			Status:    task.Status,
+			Priority:  task.Priority,
+			StartDate: formatTime(task.StartDate),
+			DueDate:   formatDueDate(task.DueDate, task.DueAllDay),
+			DueAllDay: task.DueAllDay,
+			Overdue:   task.Overdue,
+			DependsOn: task.DependsOn,
+		}
+	}
+
+	return c.JSON(http.StatusOK, TimelineResponse{
+		From:  resp.From.Format(time.RFC3339),
+		To:    resp.To.Format(time.RFC3339),
+		Tasks: tasks,
+	})
+}
+
+// AddDependencyはタスクに依存先タスクを追加
+// POST /tasks/:id/dependencies
+func (h *TaskHandler) AddDependency(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	var req AddDependencyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	resp, err := h.taskUseCase.AddDependency(c.Request().Context(), userID, taskID, taskuc.AddDependencyRequest{
+		DependsOnTaskID: req.DependsOnTaskID,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, DependencyResponse{
+		TaskID:          resp.TaskID,
+		DependsOnTaskID: resp.DependsOnTaskID,
+		CreatedBy:       resp.CreatedBy,
+		CreatedAt:       resp.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+// RemoveDependencyはタスクの依存関係を削除
+// DELETE /tasks/:id/dependencies/:dependsOnId
+func (h *TaskHandler) RemoveDependency(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	dependsOnTaskID, err := strconv.ParseInt(c.Param("dependsOnId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_TASK_ID",
+			Message: "invalid task id",
+		})
+	}
+
+	if err := h.taskUseCase.RemoveDependency(c.Request().Context(), userID, taskID, dependsOnTaskID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// parseTimelineBoundはタイムラインの期間指定をパースし、日付のみの指定かどうかを返す
+func parseTimelineBound(value string) (time.Time, bool, error) {
+	parsed, dateOnly, err := parseDueDate(&value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return *parsed, dateOnly, nil
+}
@@ -2,35 +2,87 @@ package handler
 
 // CreateTaskRequestはタスク作成のリクエスト
 type CreateTaskRequest struct {
-	Title       string  `json:"title" validate:"required"`
-	Description *string `json:"description"`
-	DueDate     *string `json:"dueDate"`
-	Priority    int     `json:"priority"`
-	AssigneeIDs []int64 `json:"assigneeIds"`
+	Title            string  `json:"title" validate:"required"`
+	Description      *string `json:"description"`
+	StartDate        *string `json:"startDate"`
+	DueDate          *string `json:"dueDate"`
+	Priority         int     `json:"priority"`
+	AssigneeIDs      []int64 `json:"assigneeIds"`
+	AssigneeGroupIDs []int64 `json:"assigneeGroupIds"`
+}
+
+// QuickAddRequestはクイック追加のリクエスト（例: "Fix login bug tomorrow 5pm !4 @alice #backend"）
+type QuickAddRequest struct {
+	Input string `json:"input"`
+}
+
+// QuickAddResponseはクイック追加のレスポンス（dryRunの場合taskはnull）
+type QuickAddResponse struct {
+	Parsed QuickAddParsedResponse `json:"parsed"`
+	Task   *TaskResponse          `json:"task"`
+}
+
+// QuickAddParsedResponseは入力の解析結果
+type QuickAddParsedResponse struct {
+	Title     string            `json:"title"`
+	DueDate   *string           `json:"dueDate"`
+	DueAllDay bool              `json:"dueAllDay"`
+	Priority  *int              `json:"priority"`
+	Assignees []MentionResponse `json:"assignees"`
+	Labels    []string          `json:"labels"`
+}
+
+// MentionResponseはメンションと対応するユーザー（見つからない・複数に一致する場合userIdはnull）
+type MentionResponse struct {
+	Mention string `json:"mention"`
+	UserID  *int64 `json:"userId"`
 }
 
 // UpdateTaskRequestはタスク更新のリクエスト
 type UpdateTaskRequest struct {
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	DueDate     *string `json:"dueDate"`
-	Status      *string `json:"status"`
-	Priority    *int    `json:"priority"`
-	AssigneeIDs []int64 `json:"assigneeIds"`
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	StartDate        *string `json:"startDate"`
+	DueDate          *string `json:"dueDate"`
+	Status           *string `json:"status"`
+	Priority         *int    `json:"priority"`
+	AssigneeIDs      []int64 `json:"assigneeIds"`
+	AssigneeGroupIDs []int64 `json:"assigneeGroupIds"`
 }
 
 // TaskResponseはタスクのレスポンス
 type TaskResponse struct {
-	ID          int64              `json:"id"`
-	OwnerID     int64              `json:"ownerId"`
-	Title       string             `json:"title"`
-	Description *string            `json:"description"`
-	DueDate     *string            `json:"dueDate"`
-	Status      string             `json:"status"`
-	Priority    int                `json:"priority"`
-	Assignees   []AssigneeResponse `json:"assignees"`
-	CreatedAt   string             `json:"createdAt"`
-	UpdatedAt   string             `json:"updatedAt"`
+	ID              int64                   `json:"id"`
+	OwnerID         int64                   `json:"ownerId"`
+	Title           string                  `json:"title"`
+	Description     *string                 `json:"description"`
+	DescriptionHTML *string                 `json:"descriptionHtml"`
+	StartDate       *string                 `json:"startDate"`
+	DueDate         *string                 `json:"dueDate"`
+	DueAllDay       bool                    `json:"dueAllDay"`
+	Overdue         bool                    `json:"overdue"`
+	Status          string                  `json:"status"`
+	Priority        int                     `json:"priority"`
+	StartedAt       *string                 `json:"startedAt"`
+	CompletedAt     *string                 `json:"completedAt"`
+	SLA             *SLAResponse            `json:"sla"`
+	SLABreached     bool                    `json:"slaBreached"`
+	Assignees       []AssigneeResponse      `json:"assignees"`
+	GroupAssignees  []GroupAssigneeResponse `json:"groupAssignees"`
+	Version         int64                   `json:"version"`
+	CreatedAt       string                  `json:"createdAt"`
+	UpdatedAt       string                  `json:"updatedAt"`
+}
+
+// SLAResponseはタスクのSLA達成状況のレスポンス
+type SLAResponse struct {
+	StartDeadline      string  `json:"startDeadline"`
+	ResolveDeadline    string  `json:"resolveDeadline"`
+	TimeToStartMinutes *int    `json:"timeToStartMinutes"`
+	TimeToDoneMinutes  *int    `json:"timeToDoneMinutes"`
+	StartBreached      bool    `json:"startBreached"`
+	ResolveBreached    bool    `json:"resolveBreached"`
+	EscalatedAt        *string `json:"escalatedAt"`
 }
 
 // AssigneeResponseはアサイン情報のレスポンス
@@ -40,3 +92,258 @@ type AssigneeResponse struct {
 	AssignedAt string `json:"assignedAt"`
 }
 
+// GroupAssigneeResponseはグループアサイン情報のレスポンス
+type GroupAssigneeResponse struct {
+	GroupID    int64  `json:"groupId"`
+	AssignedBy int64  `json:"assignedBy"`
+	AssignedAt string `json:"assignedAt"`
+}
+
+// ShareTaskRequestはタスク共有のリクエスト
+type ShareTaskRequest struct {
+	Permission string `json:"permission" validate:"required"`
+}
+
+// ShareResponseは共有設定のレスポンス
+type ShareResponse struct {
+	UserID     int64  `json:"userId"`
+	Permission string `json:"permission"`
+	SharedBy   int64  `json:"sharedBy"`
+	SharedAt   string `json:"sharedAt"`
+}
+
+// RevisionResponseはタスクのリビジョン（ある時点の内容）のレスポンス
+// changedByはSLAエスカレーションなどシステムによる変更の場合null
+type RevisionResponse struct {
+	Revision         int64   `json:"revision"`
+	Title            string  `json:"title"`
+	Description      *string `json:"description"`
+	StartDate        *string `json:"startDate"`
+	DueDate          *string `json:"dueDate"`
+	Status           string  `json:"status"`
+	Priority         int     `json:"priority"`
+	AssigneeIDs      []int64 `json:"assigneeIds"`
+	AssigneeGroupIDs []int64 `json:"assigneeGroupIds"`
+	ChangedBy        *int64  `json:"changedBy"`
+	CreatedAt        string  `json:"createdAt"`
+}
+
+// RevisionDiffResponseはリビジョン間の差分のレスポンス
+type RevisionDiffResponse struct {
+	From    int64                 `json:"from"`
+	To      int64                 `json:"to"`
+	Changes []FieldChangeResponse `json:"changes"`
+}
+
+// FieldChangeResponseは項目の変更前後の値（値の形式はRevisionResponseの同名の項目と同じ）
+type FieldChangeResponse struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// StatsResponseはダッシュボード用のタスクの集計のレスポンス
+// byPriorityのキーは優先度（JSONでは文字列）
+type StatsResponse struct {
+	Total       int            `json:"total"`
+	ByStatus    map[string]int `json:"byStatus"`
+	ByPriority  map[int]int    `json:"byPriority"`
+	Overdue     int            `json:"overdue"`
+	DueThisWeek int            `json:"dueThisWeek"`
+	Owned       int            `json:"owned"`
+	Assigned    int            `json:"assigned"`
+}
+
+// DurationStatsResponseはリードタイム・サイクルタイムの統計のレスポンス（時間単位、小数点以下2桁）
+type DurationStatsResponse struct {
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	Count        int     `json:"count"`
+	AverageHours float64 `json:"averageHours"`
+	MedianHours  float64 `json:"medianHours"`
+	P85Hours     float64 `json:"p85Hours"`
+}
+
+// ThroughputResponseは週ごとの完了数のレスポンス
+type ThroughputResponse struct {
+	From  string                     `json:"from"`
+	To    string                     `json:"to"`
+	Weeks []WeeklyThroughputResponse `json:"weeks"`
+}
+
+// WeeklyThroughputResponseは1週間（月曜日から）の完了数
+type WeeklyThroughputResponse struct {
+	WeekStart string `json:"weekStart"`
+	Completed int    `json:"completed"`
+}
+
+// StatusSnapshotsResponseは日ごとのステータス別タスク数のレスポンス（累積フロー図・バーンダウン用）
+type StatusSnapshotsResponse struct {
+	From string                   `json:"from"`
+	To   string                   `json:"to"`
+	Days []StatusSnapshotResponse `json:"days"`
+}
+
+// StatusSnapshotResponseはある日の終わり時点のステータス別タスク数
+type StatusSnapshotResponse struct {
+	Date   string         `json:"date"`
+	Counts map[string]int `json:"counts"`
+}
+
+// WorkloadResponseは担当者ごとの負荷レポートのレスポンス
+type WorkloadResponse struct {
+	Users []WorkloadUserResponse `json:"users"`
+}
+
+// WorkloadUserResponseは1人の担当者に直接アサインされているタスクの件数
+// byPriorityのキーは優先度（JSONでは文字列）
+type WorkloadUserResponse struct {
+	UserID     int64       `json:"userId"`
+	Name       string      `json:"name"`
+	Email      string      `json:"email"`
+	Total      int         `json:"total"`
+	ByPriority map[int]int `json:"byPriority"`
+	Overdue    int         `json:"overdue"`
+}
+
+// TimelineResponseはタイムライン（ガントチャート）のレスポンス
+type TimelineResponse struct {
+	From  string                 `json:"from"`
+	To    string                 `json:"to"`
+	Tasks []TimelineTaskResponse `json:"tasks"`
+}
+
+// TimelineTaskResponseはタイムライン上のタスク
+type TimelineTaskResponse struct {
+	ID        int64   `json:"id"`
+	Title     string  `json:"title"`
+	Status    string  `json:"status"`
+	Priority  int     `json:"priority"`
+	StartDate *string `json:"startDate"`
+	DueDate   *string `json:"dueDate"`
+	DueAllDay bool    `json:"dueAllDay"`
+	Overdue   bool    `json:"overdue"`
+	DependsOn []int64 `json:"dependsOn"`
+}
+
+// AddDependencyRequestは依存関係追加のリクエスト
+type AddDependencyRequest struct {
+	DependsOnTaskID int64 `json:"dependsOnTaskId" validate:"required"`
+}
+
+// DependencyResponseは依存関係のレスポンス
+type DependencyResponse struct {
+	TaskID          int64  `json:"taskId"`
+	DependsOnTaskID int64  `json:"dependsOnTaskId"`
+	CreatedBy       int64  `json:"createdBy"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+// BulkTaskRequestはタスク一括操作のリクエスト
+type BulkTaskRequest struct {
+	TaskIDs           []int64 `json:"taskIds" validate:"required"`
+	Mode              string  `json:"mode"`
+	Status            *string `json:"status"`
+	Priority          *int    `json:"priority"`
+	DueDate           *string `json:"dueDate"`
+	AddAssigneeIDs    []int64 `json:"addAssigneeIds"`
+	RemoveAssigneeIDs []int64 `json:"removeAssigneeIds"`
+	Delete            bool    `json:"delete"`
+}
+
+// BulkTaskResponseはタスク一括操作のレスポンス
+type BulkTaskResponse struct {
+	Mode      string           `json:"mode"`
+	Applied   bool             `json:"applied"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkTaskResult `json:"results"`
+}
+
+// BulkTaskResultはタスクごとの一括操作の結果
+type BulkTaskResult struct {
+	TaskID  int64          `json:"taskId"`
+	Success bool           `json:"success"`
+	Error   *ErrorResponse `json:"error,omitempty"`
+}
+
+// ImportTaskRowはJSONインポートの1行
+type ImportTaskRow struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	DueDate       string `json:"dueDate"`
+	Priority      *int   `json:"priority"`
+	Status        string `json:"status"`
+	AssigneeEmail string `json:"assigneeEmail"`
+}
+
+// ImportTasksResponseはタスク一括インポートの結果
+type ImportTasksResponse struct {
+	DryRun   bool             `json:"dryRun"`
+	Total    int              `json:"total"`
+	Valid    int              `json:"valid"`
+	Imported int              `json:"imported"`
+	TaskIDs  []int64          `json:"taskIds"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+// ImportRowErrorはインポート時の行ごとのエラー
+type ImportRowError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ExportTaskはエクスポートするタスク（JSON形式）
+type ExportTask struct {
+	ID          int64    `json:"id"`
+	Title       string   `json:"title"`
+	Description *string  `json:"description"`
+	Status      string   `json:"status"`
+	Priority    int      `json:"priority"`
+	StartDate   *string  `json:"startDate"`
+	DueDate     *string  `json:"dueDate"`
+	Assignees   []string `json:"assignees"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+// SearchResultResponseはタスク検索の1件の結果
+type SearchResultResponse struct {
+	Task       TaskResponse     `json:"task"`
+	Score      float64          `json:"score"`
+	Highlights SearchHighlights `json:"highlights"`
+}
+
+// SearchHighlightsは検索語の一致箇所を<mark>で囲んだHTMLエスケープ済みのスニペット
+type SearchHighlights struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+}
+
+// TaskListResponseはタスク一覧のレスポンス
+// NextCursorは次のページがない場合null、TotalはincludeTotal=trueの場合のみ含める
+type TaskListResponse struct {
+	Items      []TaskResponse `json:"items"`
+	NextCursor *string        `json:"nextCursor"`
+	Total      *int           `json:"total,omitempty"`
+}
+
+// TaskFilterRequestはタスク一覧の絞り込み条件と並び順（保存済みビューのリクエスト・レスポンスで使う）
+// 各項目の意味はGET /tasksのクエリパラメータと同じ
+type TaskFilterRequest struct {
+	Status      []string `json:"status,omitempty"`
+	PriorityMin *int     `json:"priorityMin,omitempty"`
+	PriorityMax *int     `json:"priorityMax,omitempty"`
+	DueFrom     *string  `json:"dueFrom,omitempty"`
+	DueTo       *string  `json:"dueTo,omitempty"`
+	CreatedFrom *string  `json:"createdFrom,omitempty"`
+	CreatedTo   *string  `json:"createdTo,omitempty"`
+	UpdatedFrom *string  `json:"updatedFrom,omitempty"`
+	UpdatedTo   *string  `json:"updatedTo,omitempty"`
+	Overdue     *bool    `json:"overdue,omitempty"`
+	Role        string   `json:"role,omitempty"`
+	AssigneeID  *int64   `json:"assigneeId,omitempty"`
+	Sort        string   `json:"sort,omitempty"`
+	Order       string   `json:"order,omitempty"`
+}
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	viewuc "github.com/ryusuke/task_app_layerx/internal/usecase/view"
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
+)
+
+// ViewHandlerは保存済みビューのHTTPハンドラー
+type ViewHandler struct {
+	viewUseCase  *viewuc.ViewUseCase
+	cursorSigner cursor.Signer
+}
+
+// NewViewHandlerで新しいViewHandlerを作成
+func NewViewHandler(viewUseCase *viewuc.ViewUseCase, cursorSigner cursor.Signer) *ViewHandler {
+	return &ViewHandler{
+		viewUseCase:  viewUseCase,
+		cursorSigner: cursorSigner,
+	}
+}
+
+// ListViewsは自分のビューと共有されたビューの一覧を取得
+// GET /views
+func (h *ViewHandler) ListViews(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	resp, err := h.viewUseCase.ListViews(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	views := make([]ViewResponse, len(resp))
+	for i, view := range resp {
+		views[i] = toViewResponse(view)
+	}
+
+	return c.JSON(http.StatusOK, views)
+}
+
+// CreateViewはビューを作成
+// POST /views
+func (h *ViewHandler) CreateView(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	var req CreateViewRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	filter, errResp := toTaskFilter(req.Filter)
+	if errResp != nil {
+		return c.JSON(http.StatusBadRequest, errResp)
+	}
+
+	resp, err := h.viewUseCase.CreateView(c.Request().Context(), userID, viewuc.CreateViewRequest{
+		Name:   req.Name,
+		Filter: filter,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toViewResponse(resp))
+}
+
+// GetViewはビューを取得
+// GET /views/:id
+func (h *ViewHandler) GetView(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+
+	resp, err := h.viewUseCase.GetView(c.Request().Context(), userID, viewID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toViewResponse(resp))
+}
+
+// UpdateViewはビューの名前・絞り込み条件を更新（filterは指定した内容で置き換える）
+// PATCH /views/:id
+func (h *ViewHandler) UpdateView(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+
+	var req UpdateViewRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "invalid request body",
+		})
+	}
+
+	updateReq := viewuc.UpdateViewRequest{Name: req.Name}
+	if req.Filter != nil {
+		filter, errResp := toTaskFilter(*req.Filter)
+		if errResp != nil {
+			return c.JSON(http.StatusBadRequest, errResp)
+		}
+		updateReq.Filter = &filter
+	}
+
+	resp, err := h.viewUseCase.UpdateView(c.Request().Context(), userID, viewID, updateReq)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toViewResponse(resp))
+}
+
+// DeleteViewはビューを削除
+// DELETE /views/:id
+func (h *ViewHandler) DeleteView(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+
+	if err := h.viewUseCase.DeleteView(c.Request().Context(), userID, viewID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ShareViewはビューをユーザーと共有
+// PUT /views/:id/shares/:userId
+func (h *ViewHandler) ShareView(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+	targetUserID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_USER_ID",
+			Message: "invalid user id",
+		})
+	}
+
+	resp, err := h.viewUseCase.ShareView(c.Request().Context(), userID, viewID, targetUserID)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toViewShareResponse(*resp))
+}
+
+// RevokeViewShareはビューの共有を解除
+// DELETE /views/:id/shares/:userId
+func (h *ViewHandler) RevokeViewShare(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+	targetUserID, err := strconv.ParseInt(c.Param("userId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    "INVALID_USER_ID",
+			Message: "invalid user id",
+		})
+	}
+
+	if err := h.viewUseCase.RevokeViewShare(c.Request().Context(), userID, viewID, targetUserID); err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListViewTasksはビューの条件でタスク一覧を取得（レスポンスはGET /tasksと同じ）
+// GET /views/:id/tasks?limit=&cursor=&includeTotal=
+func (h *ViewHandler) ListViewTasks(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+
+	viewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return invalidViewID(c)
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	includeTotal, _ := strconv.ParseBool(c.QueryParam("includeTotal"))
+
+	after, err := decodeTaskCursor(h.cursorSigner, c.QueryParam("cursor"))
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	resp, err := h.viewUseCase.ListViewTasks(c.Request().Context(), userID, viewID, viewuc.ListViewTasksRequest{
+		After:        after,
+		Limit:        limit,
+		IncludeTotal: includeTotal,
+	})
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	list, err := toTaskListResponse(h.cursorSigner, resp)
+	if err != nil {
+		return HandleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
+
+// invalidViewIDはビューIDの形式エラーのレスポンスを返す
+func invalidViewID(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, ErrorResponse{
+		Code:    "INVALID_VIEW_ID",
+		Message: "invalid view id",
+	})
+}
+
+// toViewResponseはユースケースのレスポンスをHTTPレスポンスに変換
+func toViewResponse(view *viewuc.ViewResponse) ViewResponse {
+	response := ViewResponse{
+		ID:        view.ID,
+		OwnerID:   view.OwnerID,
+		Name:      view.Name,
+		Filter:    toTaskFilterRequest(view.Filter),
+		CreatedAt: view.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: view.UpdatedAt.Format(time.RFC3339),
+	}
+	if view.SharedWith != nil {
+		response.SharedWith = make([]ViewShareResponse, len(view.SharedWith))
+		for i, share := range view.SharedWith {
+			response.SharedWith[i] = toViewShareResponse(share)
+		}
+	}
+	return response
+}
+
+// toViewShareResponseはビューの共有先をHTTPレスポンスに変換
+func toViewShareResponse(share viewuc.ViewShareResponse) ViewShareResponse {
+	return ViewShareResponse{
+		UserID:   share.UserID,
+		SharedBy: share.SharedBy,
+		SharedAt: share.SharedAt.Format(time.RFC3339),
+	}
+}
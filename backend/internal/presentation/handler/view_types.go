@@ -0,0 +1,32 @@
+package handler
+
+// CreateViewRequestは保存済みビュー作成のリクエスト
+type CreateViewRequest struct {
+	Name   string            `json:"name" validate:"required"`
+	Filter TaskFilterRequest `json:"filter"`
+}
+
+// UpdateViewRequestは保存済みビュー更新のリクエスト
+type UpdateViewRequest struct {
+	Name   *string            `json:"name"`
+	Filter *TaskFilterRequest `json:"filter"`
+}
+
+// ViewResponseは保存済みビューのレスポンス
+// sharedWithはオーナーにのみ返す
+type ViewResponse struct {
+	ID         int64               `json:"id"`
+	OwnerID    int64               `json:"ownerId"`
+	Name       string              `json:"name"`
+	Filter     TaskFilterRequest   `json:"filter"`
+	SharedWith []ViewShareResponse `json:"sharedWith,omitempty"`
+	CreatedAt  string              `json:"createdAt"`
+	UpdatedAt  string              `json:"updatedAt"`
+}
+
+// ViewShareResponseはビューの共有先のレスポンス
+type ViewShareResponse struct {
+	UserID   int64  `json:"userId"`
+	SharedBy int64  `json:"sharedBy"`
+	SharedAt string `json:"sharedAt"`
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	idempotencyuc "github.com/ryusuke/task_app_layerx/internal/usecase/idempotency"
+)
+
+// maxIdempotentBodySizeはIdempotency-Keyを処理するリクエストボディの最大サイズ（インポートの上限と同じ5MB）
+// 識別用のハッシュを作るためにボディ全体をメモリに読み込むため、ハンドラーより前で上限を超えたボディを拒否する
+const maxIdempotentBodySize = 5 << 20
+
+// replayedHeadersは処理結果とともに保存し、リトライ時に再送するレスポンスヘッダー
+var replayedHeaders = []string{echo.HeaderContentType, echo.HeaderLocation, "ETag"}
+
+// IdempotencyMiddlewareはIdempotency-Keyヘッダーが指定されたリクエストの処理結果を保存し、
+// 同じキーのリトライには処理を実行せずに保存したレスポンスを返すミドルウェア
+// キーはユーザーごとに区別するため、認証が必要なエンドポイントではJWTMiddlewareの後に適用する
+// レスポンスのボディをそのまま保存するため、トークンなどの秘密情報を返すエンドポイントには適用しない
+func IdempotencyMiddleware(idempotencyUseCase *idempotencyuc.IdempotencyUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get("Idempotency-Key")
+			if key == "" {
+				return next(c)
+			}
+			userID := GetUserID(c)
+
+			// ボディを読み込んでリクエストの内容を識別し、ハンドラー用に戻す
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxIdempotentBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{
+						"code":    "PAYLOAD_TOO_LARGE",
+						"message": "request body must be 5MB or smaller",
+					})
+				}
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": "failed to read request body",
+				})
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+			fingerprint := domain.IdempotencyFingerprint(c.Request().Method, c.Request().URL.RequestURI(), body)
+
+			stored, err := idempotencyUseCase.Begin(c.Request().Context(), userID, key, fingerprint)
+			if err != nil {
+				return idempotencyError(c, err)
+			}
+			if stored != nil {
+				return replay(c, stored)
+			}
+
+			// レスポンスを記録しながらハンドラーを実行する
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			err = next(c)
+			c.Response().Writer = recorder.ResponseWriter
+
+			// クライアントが切断しても処理結果は保存する
+			ctx := context.WithoutCancel(c.Request().Context())
+
+			// サーバーエラーやレスポンスを返せなかった場合は、同じキーでリトライできるようにする
+			status := c.Response().Status
+			if (err != nil && !c.Response().Committed) || status >= http.StatusInternalServerError {
+				if releaseErr := idempotencyUseCase.Release(ctx, userID, key); releaseErr != nil {
+					log.Printf("failed to release idempotency key: %v", releaseErr)
+				}
+				return err
+			}
+
+			headers := make(map[string]string)
+			for _, name := range replayedHeaders {
+				if value := c.Response().Header().Get(name); value != "" {
+					headers[name] = value
+				}
+			}
+			if completeErr := idempotencyUseCase.Complete(ctx, userID, key, status, headers, recorder.body.Bytes()); completeErr != nil {
+				log.Printf("failed to save idempotent response: %v", completeErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// replayは保存したレスポンスを再送する
+func replay(c echo.Context, record *domain.IdempotencyRecord) error {
+	for name, value := range record.ResponseHeaders {
+		c.Response().Header().Set(name, value)
+	}
+	c.Response().Header().Set("Idempotent-Replayed", "true")
+
+	c.Response().WriteHeader(record.StatusCode)
+	_, err := c.Response().Write(record.ResponseBody)
+	return err
+}
+
+// idempotencyErrorは冪等キーのエラーをレスポンスに変換する
+func idempotencyError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidIdempotencyKey):
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"code":    "INVALID_IDEMPOTENCY_KEY",
+			"message": err.Error(),
+		})
+	case errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return c.JSON(http.StatusUnprocessableEntity, map[string]string{
+			"code":    "IDEMPOTENCY_KEY_REUSED",
+			"message": err.Error(),
+		})
+	case errors.Is(err, domain.ErrIdempotencyKeyInUse):
+		return c.JSON(http.StatusConflict, map[string]string{
+			"code":    "IDEMPOTENCY_KEY_IN_USE",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("idempotency check failed: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"code":    "INTERNAL_ERROR",
+			"message": "internal server error",
+		})
+	}
+}
+
+// responseRecorderはクライアントに送るレスポンスボディを記録する
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// Writeはボディを記録しつつクライアントに書き込む
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // JWTMiddlewareはJWT認証を行うミドルウェア
-func JWTMiddleware(jwtService auth.JWTService, userRepo domain.UserRepository, executor domain.Executor) echo.MiddlewareFunc {
+// トークンのsidクレームのセッションが無効になっている場合（その端末からのログアウトなど）は認証しない
+func JWTMiddleware(jwtService auth.JWTService, userRepo domain.UserRepository, sessionRepo domain.SessionRepository, executor domain.Executor) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			// Authorizationヘッダーからトークンを取得
@@ -70,8 +71,24 @@ func JWTMiddleware(jwtService auth.JWTService, userRepo domain.UserRepository, e
 				})
 			}
 
-			// コンテキストにユーザーIDを保存
+			// セッションチェック（他のユーザーのセッションIDを含むトークンも無効とする）
+			session, err := sessionRepo.FindByID(c.Request().Context(), executor, claims.SessionID)
+			if err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"code":    "INTERNAL_ERROR",
+					"message": "internal server error",
+				})
+			}
+			if err != nil || session.UserID != user.ID || session.IsRevoked() {
+				return c.JSON(http.StatusUnauthorized, map[string]string{
+					"code":    "SESSION_REVOKED",
+					"message": "session has been logged out, please login again",
+				})
+			}
+
+			// コンテキストにユーザーID・セッションIDを保存
 			c.Set("userID", user.ID)
+			c.Set("sessionID", session.ID)
 
 			return next(c)
 		}
@@ -86,3 +103,12 @@ func GetUserID(c echo.Context) int64 {
 	}
 	return userID
 }
+
+// GetSessionIDはコンテキストからセッションIDを取得
+func GetSessionID(c echo.Context) int64 {
+	sessionID, ok := c.Get("sessionID").(int64)
+	if !ok {
+		return 0
+	}
+	return sessionID
+}
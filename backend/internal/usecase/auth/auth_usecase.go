@@ -9,29 +9,49 @@ import (
 	"github.com/ryusuke/task_app_layerx/internal/domain"
 	"github.com/ryusuke/task_app_layerx/pkg/auth"
 	"github.com/ryusuke/task_app_layerx/pkg/hash"
+	"github.com/ryusuke/task_app_layerx/pkg/signedtoken"
 )
 
 type AuthUseCase struct {
-	userRepo   domain.UserRepository
-	txManager  domain.TxManager
-	clock      domain.Clock
-	jwtService auth.JWTService
-	bcrypt     hash.BcryptService
+	userRepo          domain.UserRepository
+	sessionRepo       domain.SessionRepository
+	refreshTokenRepo  domain.RefreshTokenRepository
+	passwordResetRepo domain.PasswordResetTokenRepository
+	mailSender        domain.MailSender
+	txManager         domain.TxManager
+	clock             domain.Clock
+	jwtService        auth.JWTService
+	bcrypt            hash.BcryptService
+	// verificationSignerはメールアドレス確認リンクのトークンに署名する
+	verificationSigner signedtoken.Signer
+	config             Config
 }
 
 func NewAuthUseCase(
 	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	passwordResetRepo domain.PasswordResetTokenRepository,
+	mailSender domain.MailSender,
 	txManager domain.TxManager,
 	clock domain.Clock,
 	jwtService auth.JWTService,
 	bcrypt hash.BcryptService,
+	verificationSigner signedtoken.Signer,
+	config Config,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:   userRepo,
-		txManager:  txManager,
-		clock:      clock,
-		jwtService: jwtService,
-		bcrypt:     bcrypt,
+		userRepo:           userRepo,
+		sessionRepo:        sessionRepo,
+		refreshTokenRepo:   refreshTokenRepo,
+		passwordResetRepo:  passwordResetRepo,
+		mailSender:         mailSender,
+		txManager:          txManager,
+		clock:              clock,
+		jwtService:         jwtService,
+		bcrypt:             bcrypt,
+		verificationSigner: verificationSigner,
+		config:             config,
 	}
 }
 
@@ -44,7 +64,7 @@ func (u *AuthUseCase) Signup(ctx context.Context, req SignupRequest) (*AuthRespo
 	if email == "" {
 		return nil, domain.ErrInvalidEmail
 	}
-	if len(req.Password) < 8 {
+	if len(req.Password) < minPasswordLength {
 		return nil, domain.ErrPasswordTooShort
 	}
 	if name == "" {
@@ -52,6 +72,7 @@ func (u *AuthUseCase) Signup(ctx context.Context, req SignupRequest) (*AuthRespo
 	}
 
 	var response *AuthResponse
+	var mail *domain.Mail
 
 	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
 		// メールアドレスの重複チェック
@@ -75,28 +96,65 @@ func (u *AuthUseCase) Signup(ctx context.Context, req SignupRequest) (*AuthRespo
 			return err
 		}
 		user.SetPasswordHash(u.clock, hashedPassword)
+		if req.Timezone != "" {
+			if err := user.UpdateTimezone(u.clock, req.Timezone); err != nil {
+				return err
+			}
+		}
 
 		// ユーザー保存
 		if err := u.userRepo.Create(ctx, ex, user); err != nil {
 			return fmt.Errorf("failed to create user: %w", err)
 		}
 
-		// JWTトークン生成
-		token, err := u.jwtService.GenerateToken(user.ID, user.TokenVersion)
+		// メールアドレス確認リンクのメールを作成（送信はコミット後）
+		mail, err = u.verificationMail(user)
 		if err != nil {
-			return fmt.Errorf("failed to generate token: %w", err)
+			return err
 		}
 
-		response = &AuthResponse{
-			Token: token,
-			User: UserResponse{
-				ID:    user.ID,
-				Email: user.Email,
-				Name:  user.Name,
-			},
+		// 未確認のユーザーがログインできない設定では、確認するまでトークンを発行しない
+		if !u.config.UnverifiedUserPolicy.CanLogin(user) {
+			response = &AuthResponse{User: toUserResponse(user)}
+			return nil
 		}
 
-		return nil
+		// セッションを開始してアクセストークン・リフレッシュトークン発行
+		response, err = u.startSession(ctx, ex, user, req.UserAgent, req.IPAddress)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	go u.sendMail(context.WithoutCancel(ctx), response.User.ID, *mail)
+
+	return response, nil
+}
+
+// ReplaySignupは同じIdempotency-Keyで登録済みのユーザーに対するリトライを処理する
+// 最初の登録のレスポンス（トークン）は保存していないため、パスワードを確認してから新しいセッションを開始する
+// パスワードが異なる場合は別のリクエストでキーを再利用したものとみなす
+func (u *AuthUseCase) ReplaySignup(ctx context.Context, userID int64, req SignupRequest) (*AuthResponse, error) {
+	user, err := u.userRepo.FindByID(ctx, u.txManager.AsExecutor(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.bcrypt.VerifyPassword(user.PasswordHash, req.Password) {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+
+	// 登録時と同じく、未確認のユーザーがログインできない設定ではトークンを発行しない
+	if !u.config.UnverifiedUserPolicy.CanLogin(user) {
+		return &AuthResponse{User: toUserResponse(user)}, nil
+	}
+
+	var response *AuthResponse
+	err = u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		response, err = u.startSession(ctx, ex, user, req.UserAgent, req.IPAddress)
+		return err
 	})
 
 	if err != nil {
@@ -118,7 +176,6 @@ func (u *AuthUseCase) Login(ctx context.Context, req LoginRequest) (*AuthRespons
 		return nil, domain.ErrInvalidPassword
 	}
 
-	// DBExecutorを作成（読み込み専用なのでトランザクション不要）
 	executor := u.txManager.AsExecutor()
 
 	// メールアドレスでユーザー検索
@@ -133,28 +190,138 @@ func (u *AuthUseCase) Login(ctx context.Context, req LoginRequest) (*AuthRespons
 		return nil, domain.ErrUnauthorized
 	}
 
-	// JWTトークン生成
-	token, err := u.jwtService.GenerateToken(user.ID, user.TokenVersion)
+	// メールアドレス未確認のユーザーに課す制限
+	if !u.config.UnverifiedUserPolicy.CanLogin(user) {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	// セッションを開始してアクセストークン・リフレッシュトークン発行
+	var response *AuthResponse
+	err = u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		response, err = u.startSession(ctx, ex, user, req.UserAgent, req.IPAddress)
+		return err
+	})
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
 	}
 
-	return &AuthResponse{
-		Token: token,
-		User: UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-		},
-	}, nil
+	return response, nil
 }
 
-func (u *AuthUseCase) Logout(ctx context.Context, userID int64) error {
-	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+// Refreshはリフレッシュトークンでアクセストークンを再発行し、リフレッシュトークンを新しいものに置き換える
+// 置き換え済みのトークンが再び使われた場合は漏洩とみなし、そのトークンのセッションを無効にする
+func (u *AuthUseCase) Refresh(ctx context.Context, req RefreshRequest) (*AuthResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, domain.ErrInvalidToken
+	}
+
+	var response *AuthResponse
+	// 再利用を検出した場合もセッションの無効化はコミットするため、エラーはトランザクションの外で返す
+	reused := false
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		token, err := u.refreshTokenRepo.FindByTokenHash(ctx, ex, domain.HashRefreshToken(req.RefreshToken))
+		if err != nil {
+			if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+				return domain.ErrInvalidToken
+			}
+			return err
+		}
+
 		now := u.clock.Now()
-		// TokenVersionをインクリメント → 既存のJWTが無効化される
-		return u.userRepo.IncrementTokenVersion(ctx, ex, userID, now)
+		if token.IsExpired(now) {
+			return domain.ErrInvalidToken
+		}
+
+		session, err := u.sessionRepo.FindByID(ctx, ex, token.SessionID)
+		if err != nil {
+			if errors.Is(err, domain.ErrSessionNotFound) {
+				return domain.ErrInvalidToken
+			}
+			return err
+		}
+		if !session.IsActive(now) {
+			return domain.ErrInvalidToken
+		}
+
+		// 置き換え済みのトークンの再利用（同じトークンで同時に再発行し、後から置き換えようとした場合を含む）
+		if token.IsRotated() {
+			reused = true
+		} else if err := u.refreshTokenRepo.MarkRotated(ctx, ex, token.ID, now); err != nil {
+			if !errors.Is(err, domain.ErrRefreshTokenReused) {
+				return err
+			}
+			reused = true
+		}
+		if reused {
+			return u.sessionRepo.Revoke(ctx, ex, session.ID, now)
+		}
+
+		user, err := u.userRepo.FindByID(ctx, ex, token.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return domain.ErrInvalidToken
+			}
+			return err
+		}
+		if !u.config.UnverifiedUserPolicy.CanLogin(user) {
+			return domain.ErrEmailNotVerified
+		}
+
+		session.Touch(u.clock, req.UserAgent, req.IPAddress, u.config.RefreshTokenTTL)
+		if err := u.sessionRepo.Update(ctx, ex, session); err != nil {
+			return err
+		}
+
+		response, err = u.issueTokens(ctx, ex, user, session)
+		return err
 	})
+
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	return response, nil
+}
+
+// Logoutは現在のセッションだけをログアウトする（他の端末のセッションは有効なまま）
+func (u *AuthUseCase) Logout(ctx context.Context, userID, sessionID int64) error {
+	return u.RevokeSession(ctx, userID, sessionID)
+}
+
+// startSessionは新しいセッションを作成し、アクセストークンとリフレッシュトークンを発行
+func (u *AuthUseCase) startSession(ctx context.Context, ex domain.Executor, user *domain.User, userAgent, ipAddress string) (*AuthResponse, error) {
+	session := domain.NewSession(u.clock, user.ID, userAgent, ipAddress, u.config.RefreshTokenTTL)
+	if err := u.sessionRepo.Create(ctx, ex, session); err != nil {
+		return nil, err
+	}
+	return u.issueTokens(ctx, ex, user, session)
+}
+
+// issueTokensはセッションのアクセストークン（JWT）とリフレッシュトークンを発行
+func (u *AuthUseCase) issueTokens(ctx context.Context, ex domain.Executor, user *domain.User, session *domain.Session) (*AuthResponse, error) {
+	token, err := u.jwtService.GenerateToken(user.ID, user.TokenVersion, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	refreshToken, plainRefreshToken, err := domain.NewRefreshToken(u.clock, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	if err := u.refreshTokenRepo.Create(ctx, ex, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{
+		Token:        token,
+		RefreshToken: plainRefreshToken,
+		User:         toUserResponse(user),
+	}, nil
 }
 
 func (u *AuthUseCase) GetUsers(ctx context.Context) ([]UserResponse, error) {
@@ -166,12 +333,72 @@ func (u *AuthUseCase) GetUsers(ctx context.Context) ([]UserResponse, error) {
 
 	response := make([]UserResponse, len(users))
 	for i, user := range users {
-		response[i] = UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-		}
+		response[i] = toUserResponse(user)
 	}
 
 	return response, nil
 }
+
+// GetMeはログイン中のユーザー情報を取得
+func (u *AuthUseCase) GetMe(ctx context.Context, userID int64) (*UserResponse, error) {
+	user, err := u.userRepo.FindByID(ctx, u.txManager.AsExecutor(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toUserResponse(user)
+	return &response, nil
+}
+
+// UpdateProfileはログイン中のユーザーの名前・タイムゾーンを更新
+func (u *AuthUseCase) UpdateProfile(ctx context.Context, userID int64, req UpdateProfileRequest) (*UserResponse, error) {
+	var response UserResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		user, err := u.userRepo.FindByID(ctx, ex, userID)
+		if err != nil {
+			return err
+		}
+
+		if req.Name != nil {
+			user.Name = strings.TrimSpace(*req.Name)
+			if user.Name == "" {
+				return domain.ErrInvalidName
+			}
+			if err := user.ValidateName(); err != nil {
+				return err
+			}
+			user.UpdatedAt = u.clock.Now()
+		}
+
+		if req.Timezone != nil {
+			if err := user.UpdateTimezone(u.clock, *req.Timezone); err != nil {
+				return err
+			}
+		}
+
+		if err := u.userRepo.Update(ctx, ex, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		response = toUserResponse(user)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// toUserResponseはdomain.UserをUserResponseに変換
+func toUserResponse(user *domain.User) UserResponse {
+	return UserResponse{
+		ID:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Timezone:      user.Timezone,
+		EmailVerified: user.IsEmailVerified(),
+	}
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/signedtoken"
+)
+
+// EmailVerificationPurposeはメールアドレス確認リンクのトークンの署名に含める用途
+// （同じ署名鍵で作った他の用途のトークンを確認リンクとして使えないようにする）
+const EmailVerificationPurpose = "email_verification"
+
+// emailVerificationPayloadはメールアドレス確認リンクのトークンに署名して含める内容
+type emailVerificationPayload struct {
+	UserID    int64  `json:"uid"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// VerifyEmailはメールで送った確認リンクのトークンでメールアドレスを確認済みにする
+// 確認済みのユーザーが再びリンクを開いた場合も成功として扱う
+func (u *AuthUseCase) VerifyEmail(ctx context.Context, req VerifyEmailRequest) error {
+	var payload emailVerificationPayload
+	if err := u.verificationSigner.Decode(req.Token, &payload); err != nil {
+		if errors.Is(err, signedtoken.ErrInvalidToken) {
+			return domain.ErrInvalidVerificationToken
+		}
+		return fmt.Errorf("failed to decode email verification token: %w", err)
+	}
+	verification := domain.EmailVerification{
+		UserID:    payload.UserID,
+		Email:     payload.Email,
+		ExpiresAt: time.Unix(payload.ExpiresAt, 0),
+	}
+
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		user, err := u.userRepo.FindByID(ctx, ex, verification.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return domain.ErrInvalidVerificationToken
+			}
+			return err
+		}
+
+		if err := verification.Verify(user, u.clock.Now()); err != nil {
+			return err
+		}
+		if user.IsEmailVerified() {
+			return nil
+		}
+
+		user.VerifyEmail(u.clock)
+		if err := u.userRepo.Update(ctx, ex, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		return nil
+	})
+}
+
+// ResendVerificationEmailはメールアドレス確認リンクのメールを再送する
+// メールアドレスが登録されているかどうかを明かさないため、未登録・確認済みの場合も成功として扱う
+func (u *AuthUseCase) ResendVerificationEmail(ctx context.Context, req ResendVerificationRequest) error {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return domain.ErrInvalidEmail
+	}
+
+	user, err := u.userRepo.FindByEmail(ctx, u.txManager.AsExecutor(), email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	mail, err := u.verificationMail(user)
+	if err != nil {
+		return err
+	}
+
+	// 送信にかかる時間でメールアドレスの登録有無がわからないよう、送信は待たずに応答する
+	go u.sendMail(context.WithoutCancel(ctx), user.ID, *mail)
+
+	return nil
+}
+
+// verificationMailはユーザーの現在のメールアドレスに送る確認リンクのメールを作成
+func (u *AuthUseCase) verificationMail(user *domain.User) (*domain.Mail, error) {
+	verification := domain.NewEmailVerification(u.clock, user, u.config.EmailVerificationTTL)
+	token, err := u.verificationSigner.Encode(emailVerificationPayload{
+		UserID:    verification.UserID,
+		Email:     verification.Email,
+		ExpiresAt: verification.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign email verification token: %w", err)
+	}
+
+	return &domain.Mail{
+		To:      user.Email,
+		Subject: "メールアドレスの確認",
+		Body: fmt.Sprintf(
+			"%s さん\n\nご登録ありがとうございます。メールアドレスを確認するには、次のリンクを開いてください（有効期限: %s）。\n\n%s\n\nこのメールに心当たりがない場合は破棄してください。\n",
+			user.Name,
+			verification.ExpiresAt.In(user.Location()).Format("2006-01-02 15:04 MST"),
+			u.config.AppBaseURL+"/verify-email?token="+url.QueryEscape(token),
+		),
+	}, nil
+}
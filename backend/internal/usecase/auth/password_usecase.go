@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// minPasswordLengthはパスワードの最小文字数
+const minPasswordLength = 8
+
+// ForgotPasswordはパスワード再設定用の1回限りのリンクをメールで送る
+// メールアドレスが登録されているかどうかを明かさないため、登録されていない場合も成功として扱う
+func (u *AuthUseCase) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return domain.ErrInvalidEmail
+	}
+
+	var userID int64
+	var mail *domain.Mail
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		user, err := u.userRepo.FindByEmail(ctx, ex, email)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		// 以前に送ったリンクは無効にし、最後に送ったリンクのみ使えるようにする
+		if err := u.passwordResetRepo.DeleteUnusedByUserID(ctx, ex, user.ID); err != nil {
+			return err
+		}
+
+		token, plainToken, err := domain.NewPasswordResetToken(u.clock, user.ID, u.config.PasswordResetTTL)
+		if err != nil {
+			return fmt.Errorf("failed to generate password reset token: %w", err)
+		}
+		if err := u.passwordResetRepo.Create(ctx, ex, token); err != nil {
+			return err
+		}
+
+		userID = user.ID
+		mail = &domain.Mail{
+			To:      user.Email,
+			Subject: "パスワードの再設定",
+			Body: fmt.Sprintf(
+				"%s さん\n\nパスワードを再設定するには、次のリンクを開いてください（有効期限: %s）。\n\n%s\n\nこのメールに心当たりがない場合は破棄してください。パスワードは変更されません。\n",
+				user.Name,
+				token.ExpiresAt.In(user.Location()).Format("2006-01-02 15:04 MST"),
+				u.config.AppBaseURL+"/reset-password?token="+url.QueryEscape(plainToken),
+			),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// 送信にかかる時間でメールアドレスの登録有無がわからないよう、送信は待たずに応答する
+	if mail != nil {
+		go u.sendMail(context.WithoutCancel(ctx), userID, *mail)
+	}
+
+	return nil
+}
+
+// ResetPasswordはメールで送ったトークンでパスワードを再設定する
+// 再設定後はTokenVersionを増やし、すべてのセッションを無効にする（全端末でログアウトされる）
+func (u *AuthUseCase) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	if req.Token == "" {
+		return domain.ErrInvalidResetToken
+	}
+	if len(req.Password) < minPasswordLength {
+		return domain.ErrPasswordTooShort
+	}
+
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		token, err := u.passwordResetRepo.FindByTokenHash(ctx, ex, domain.HashPasswordResetToken(req.Token))
+		if err != nil {
+			return err
+		}
+
+		now := u.clock.Now()
+		if !token.IsUsable(now) {
+			return domain.ErrInvalidResetToken
+		}
+		if err := u.passwordResetRepo.MarkUsed(ctx, ex, token.ID, now); err != nil {
+			return err
+		}
+
+		user, err := u.userRepo.FindByID(ctx, ex, token.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return domain.ErrInvalidResetToken
+			}
+			return err
+		}
+
+		hashedPassword, err := u.bcrypt.HashPassword(req.Password)
+		if err != nil {
+			return fmt.Errorf("failed to hash password: %w", err)
+		}
+		user.SetPasswordHash(u.clock, hashedPassword)
+		// メールで送ったリンクを開けたため、メールアドレスも確認済みとする
+		user.VerifyEmail(u.clock)
+		// TokenVersionをインクリメント → 既存のJWTが無効化される
+		user.IncrementTokenVersion(u.clock)
+		if err := u.userRepo.Update(ctx, ex, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		// リフレッシュトークンでも再ログインできないよう、すべてのセッションを無効化
+		if _, err := u.sessionRepo.RevokeByUserID(ctx, ex, user.ID, 0, now); err != nil {
+			return err
+		}
+
+		return u.passwordResetRepo.DeleteUnusedByUserID(ctx, ex, user.ID)
+	})
+}
+
+// sendMailはメールを送り、失敗した場合はログに出力する
+// ログにはメールアドレスを残さず、宛先のユーザーIDのみを出力する
+func (u *AuthUseCase) sendMail(ctx context.Context, userID int64, mail domain.Mail) {
+	if err := u.mailSender.Send(ctx, mail); err != nil {
+		log.Printf("failed to send mail to user %d: %v", userID, err)
+	}
+}
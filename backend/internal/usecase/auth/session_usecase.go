@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/useragent"
+)
+
+// ListSessionsはログイン中のユーザーの有効なセッションを最終利用日時の新しい順に取得
+// currentSessionIDのセッションはCurrentをtrueにする
+func (u *AuthUseCase) ListSessions(ctx context.Context, userID, currentSessionID int64) ([]SessionResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	user, err := u.userRepo.FindByID(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := u.sessionRepo.FindActiveByUserID(ctx, executor, userID, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	loc := user.Location()
+	response := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		response[i] = SessionResponse{
+			ID:         session.ID,
+			Device:     useragent.Describe(session.UserAgent),
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt.In(loc),
+			LastUsedAt: session.LastUsedAt.In(loc),
+			ExpiresAt:  session.ExpiresAt.In(loc),
+			Current:    session.ID == currentSessionID,
+		}
+	}
+
+	return response, nil
+}
+
+// RevokeSessionはログイン中のユーザーのセッションを無効にする（その端末のみログアウトされる）
+// 他のユーザーのセッションや既に無効なセッションの場合はErrSessionNotFoundを返す
+func (u *AuthUseCase) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		session, err := u.sessionRepo.FindByID(ctx, ex, sessionID)
+		if err != nil {
+			return err
+		}
+
+		now := u.clock.Now()
+		if session.UserID != userID || !session.IsActive(now) {
+			return domain.ErrSessionNotFound
+		}
+
+		return u.sessionRepo.Revoke(ctx, ex, session.ID, now)
+	})
+}
+
+// RevokeOtherSessionsは現在のセッション以外をすべて無効にし、無効にした件数を返す（他の端末からログアウト）
+func (u *AuthUseCase) RevokeOtherSessions(ctx context.Context, userID, currentSessionID int64) (int64, error) {
+	return u.sessionRepo.RevokeByUserID(ctx, u.txManager.AsExecutor(), userID, currentSessionID, u.clock.Now())
+}
+
+// DeleteExpiredSessionsは有効期限が切れたリフレッシュトークン・パスワード再設定トークンとセッションを削除し、削除したセッションの件数を返す
+func (u *AuthUseCase) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	executor := u.txManager.AsExecutor()
+	now := u.clock.Now()
+
+	if _, err := u.refreshTokenRepo.DeleteExpired(ctx, executor, now); err != nil {
+		return 0, err
+	}
+	if _, err := u.passwordResetRepo.DeleteExpired(ctx, executor, now); err != nil {
+		return 0, err
+	}
+	return u.sessionRepo.DeleteExpired(ctx, executor, now)
+}
@@ -1,27 +1,105 @@
 package auth
 
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// Configは認証の設定
+type Config struct {
+	// RefreshTokenTTLはリフレッシュトークン（セッション）の有効期間
+	RefreshTokenTTL time.Duration
+	// PasswordResetTTLはパスワード再設定リンクの有効期間
+	PasswordResetTTL time.Duration
+	// EmailVerificationTTLはメールアドレス確認リンクの有効期間
+	EmailVerificationTTL time.Duration
+	// UnverifiedUserPolicyはメールアドレスを確認していないユーザーに課す制限
+	UnverifiedUserPolicy domain.UnverifiedUserPolicy
+	// AppBaseURLはメールに記載するリンクのベースURL（フロントエンドのURL）
+	AppBaseURL string
+}
+
 // SignupRequestはユーザー登録のリクエスト
 type SignupRequest struct {
 	Email    string
 	Password string
 	Name     string
+	Timezone string
+	// UserAgent・IPAddressはセッション一覧に表示する接続元
+	UserAgent string
+	IPAddress string
 }
 
 // LoginRequestはログインのリクエスト
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	UserAgent string
+	IPAddress string
+}
+
+// RefreshRequestはアクセストークン再発行のリクエスト
+type RefreshRequest struct {
+	RefreshToken string
+	UserAgent    string
+	IPAddress    string
 }
 
 // AuthResponseは認証成功時のレスポンス
+// メールアドレス未確認のユーザーがログインできない設定での登録時は、トークンを空にする
 type AuthResponse struct {
-	Token string
-	User  UserResponse
+	Token        string
+	RefreshToken string
+	User         UserResponse
 }
 
 // UserResponseはユーザー情報のレスポンス
 type UserResponse struct {
-	ID    int64  `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	ID       int64  `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Timezone string `json:"timezone"`
+	// EmailVerifiedはメールアドレスを確認済みかどうか
+	EmailVerified bool `json:"emailVerified"`
+}
+
+// UpdateProfileRequestはプロフィール更新のリクエスト
+type UpdateProfileRequest struct {
+	Name     *string
+	Timezone *string
+}
+
+// SessionResponseはログインセッションのレスポンス
+type SessionResponse struct {
+	ID         int64
+	Device     string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	// Currentはリクエストに使ったトークンのセッションかどうか
+	Current bool
+}
+
+// ForgotPasswordRequestはパスワード再設定メール送信のリクエスト
+type ForgotPasswordRequest struct {
+	Email string
+}
+
+// ResetPasswordRequestはパスワード再設定のリクエスト
+type ResetPasswordRequest struct {
+	Token    string
+	Password string
+}
+
+// VerifyEmailRequestはメールアドレス確認のリクエスト
+type VerifyEmailRequest struct {
+	Token string
+}
+
+// ResendVerificationRequestは確認メール再送のリクエスト
+type ResendVerificationRequest struct {
+	Email string
 }
@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// feedPageSizeはフィード作成時に1回で取得するタスク数
+const feedPageSize = 100
+
+// CalendarUseCaseはカレンダーアプリ向けicsフィードのユースケースを提供する
+type CalendarUseCase struct {
+	tokenRepo domain.CalendarTokenRepository
+	taskRepo  domain.TaskRepository
+	userRepo  domain.UserRepository
+	txManager domain.TxManager
+	clock     domain.Clock
+}
+
+// NewCalendarUseCaseで新しいCalendarUseCaseを作成
+func NewCalendarUseCase(
+	tokenRepo domain.CalendarTokenRepository,
+	taskRepo domain.TaskRepository,
+	userRepo domain.UserRepository,
+	txManager domain.TxManager,
+	clock domain.Clock,
+) *CalendarUseCase {
+	return &CalendarUseCase{
+		tokenRepo: tokenRepo,
+		taskRepo:  taskRepo,
+		userRepo:  userRepo,
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// RegenerateTokenはフィード用トークンを発行する（既存のトークンは無効になる）
+func (u *CalendarUseCase) RegenerateToken(ctx context.Context, userID int64) (*TokenResponse, error) {
+	token, plain, err := domain.NewCalendarToken(u.clock, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+
+	if err := u.tokenRepo.Upsert(ctx, u.txManager.AsExecutor(), token); err != nil {
+		return nil, fmt.Errorf("failed to save calendar token: %w", err)
+	}
+
+	return &TokenResponse{Token: plain, CreatedAt: token.CreatedAt}, nil
+}
+
+// RevokeTokenはフィード用トークンを無効にする
+func (u *CalendarUseCase) RevokeToken(ctx context.Context, userID int64) error {
+	return u.tokenRepo.DeleteByUserID(ctx, u.txManager.AsExecutor(), userID)
+}
+
+// GetFeedはトークンの持ち主が閲覧可能な期日付きタスクを取得する
+func (u *CalendarUseCase) GetFeed(ctx context.Context, token string) (*FeedResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	calendarToken, err := u.tokenRepo.FindByTokenHash(ctx, executor, domain.HashCalendarToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, executor, calendarToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeedResponse{UserName: user.Name, Tasks: []FeedTask{}, GeneratedAt: u.clock.Now()}
+	filter := domain.TaskFilter{SortKey: domain.TaskSortCreatedAt, SortDirection: domain.SortDesc}
+	var after *domain.TaskCursor
+	for {
+		tasks, err := u.taskRepo.ListByUserID(ctx, executor, user.ID, filter, after, feedPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks: %w", err)
+		}
+
+		for _, task := range tasks {
+			if task.DueDate == nil {
+				continue
+			}
+			response.Tasks = append(response.Tasks, FeedTask{
+				ID:          task.ID,
+				Title:       task.Title,
+				Description: task.Description,
+				DueDate:     *task.DueDate,
+				DueAllDay:   task.DueAllDay,
+				Status:      string(task.Status),
+				Priority:    task.Priority,
+				CompletedAt: task.CompletedAt,
+				UpdatedAt:   task.UpdatedAt,
+			})
+		}
+
+		if len(tasks) < feedPageSize {
+			return response, nil
+		}
+		after = domain.NewTaskCursor(filter, tasks[len(tasks)-1])
+	}
+}
@@ -0,0 +1,30 @@
+package calendar
+
+import "time"
+
+// TokenResponse はカレンダーフィード用トークン発行のレスポンス
+type TokenResponse struct {
+	Token     string
+	CreatedAt time.Time
+}
+
+// FeedResponse はカレンダーフィードの内容
+type FeedResponse struct {
+	UserName string
+	Tasks    []FeedTask
+	// GeneratedAtはフィードを作成した日時（DTSTAMPに使う）
+	GeneratedAt time.Time
+}
+
+// FeedTask はフィードに載せるタスク
+type FeedTask struct {
+	ID          int64
+	Title       string
+	Description *string
+	DueDate     time.Time
+	DueAllDay   bool
+	Status      string
+	Priority    int
+	CompletedAt *time.Time
+	UpdatedAt   time.Time
+}
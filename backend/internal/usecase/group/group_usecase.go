@@ -0,0 +1,263 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// GroupUseCaseはユーザーグループ管理のユースケースを提供する
+type GroupUseCase struct {
+	groupRepo  domain.GroupRepository
+	memberRepo domain.GroupMemberRepository
+	userRepo   domain.UserRepository
+	txManager  domain.TxManager
+	clock      domain.Clock
+	// unverifiedUserPolicyはメールアドレス未確認のユーザーをメンバーに追加できるかの設定
+	// （グループにアサインされたタスクはメンバーも閲覧できるため、タスクのアサインと同じ制限を適用する）
+	unverifiedUserPolicy domain.UnverifiedUserPolicy
+}
+
+// NewGroupUseCaseで新しいGroupUseCaseを作成
+func NewGroupUseCase(
+	groupRepo domain.GroupRepository,
+	memberRepo domain.GroupMemberRepository,
+	userRepo domain.UserRepository,
+	txManager domain.TxManager,
+	clock domain.Clock,
+	unverifiedUserPolicy domain.UnverifiedUserPolicy,
+) *GroupUseCase {
+	return &GroupUseCase{
+		groupRepo:            groupRepo,
+		memberRepo:           memberRepo,
+		userRepo:             userRepo,
+		txManager:            txManager,
+		clock:                clock,
+		unverifiedUserPolicy: unverifiedUserPolicy,
+	}
+}
+
+// ListGroupsはグループ一覧を取得（タスクアサイン用）
+func (u *GroupUseCase) ListGroups(ctx context.Context) ([]*GroupResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	groups, err := u.groupRepo.FindAll(ctx, executor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	responses := make([]*GroupResponse, len(groups))
+	for i, group := range groups {
+		members, err := u.memberRepo.FindByGroupID(ctx, executor, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find group members: %w", err)
+		}
+		responses[i] = toGroupResponse(group, members)
+	}
+
+	return responses, nil
+}
+
+// CreateGroupはグループを作成
+func (u *GroupUseCase) CreateGroup(ctx context.Context, userID int64, req CreateGroupRequest) (*GroupResponse, error) {
+	var response *GroupResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		group, err := domain.NewGroup(u.clock, userID, req.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := u.groupRepo.Create(ctx, ex, group); err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
+		}
+
+		members := make([]*domain.GroupMember, 0, len(req.MemberIDs))
+		for _, memberID := range req.MemberIDs {
+			member, err := u.addMember(ctx, ex, group.ID, memberID, userID)
+			if err != nil {
+				return err
+			}
+			members = append(members, member)
+		}
+
+		response = toGroupResponse(group, members)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetGroupはグループ詳細を取得
+func (u *GroupUseCase) GetGroup(ctx context.Context, groupID int64) (*GroupResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	group, err := u.groupRepo.FindByID(ctx, executor, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.memberRepo.FindByGroupID(ctx, executor, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group members: %w", err)
+	}
+
+	return toGroupResponse(group, members), nil
+}
+
+// UpdateGroupはグループを更新
+func (u *GroupUseCase) UpdateGroup(ctx context.Context, userID, groupID int64, req UpdateGroupRequest) (*GroupResponse, error) {
+	var response *GroupResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		group, err := u.groupRepo.FindByID(ctx, ex, groupID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（作成者のみ更新可能）
+		if !domain.CanManageGroup(group, userID) {
+			return domain.ErrForbidden
+		}
+
+		if req.Name != nil {
+			if err := group.Rename(u.clock, *req.Name); err != nil {
+				return err
+			}
+		}
+
+		if err := u.groupRepo.Update(ctx, ex, group); err != nil {
+			return fmt.Errorf("failed to update group: %w", err)
+		}
+
+		members, err := u.memberRepo.FindByGroupID(ctx, ex, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to find group members: %w", err)
+		}
+
+		response = toGroupResponse(group, members)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// DeleteGroupはグループを削除（タスクへのグループアサインも解除される）
+func (u *GroupUseCase) DeleteGroup(ctx context.Context, userID, groupID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		group, err := u.groupRepo.FindByID(ctx, ex, groupID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（作成者のみ削除可能）
+		if !domain.CanManageGroup(group, userID) {
+			return domain.ErrForbidden
+		}
+
+		if err := u.groupRepo.Delete(ctx, ex, groupID); err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// AddMemberはグループにメンバーを追加
+func (u *GroupUseCase) AddMember(ctx context.Context, userID, groupID, memberID int64) (*GroupResponse, error) {
+	var response *GroupResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		group, err := u.groupRepo.FindByID(ctx, ex, groupID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（作成者のみメンバー管理可能）
+		if !domain.CanManageGroup(group, userID) {
+			return domain.ErrForbidden
+		}
+
+		if _, err := u.addMember(ctx, ex, groupID, memberID, userID); err != nil {
+			return err
+		}
+
+		members, err := u.memberRepo.FindByGroupID(ctx, ex, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to find group members: %w", err)
+		}
+
+		response = toGroupResponse(group, members)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RemoveMemberはグループからメンバーを削除
+func (u *GroupUseCase) RemoveMember(ctx context.Context, userID, groupID, memberID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		group, err := u.groupRepo.FindByID(ctx, ex, groupID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（作成者のみメンバー管理可能）
+		if !domain.CanManageGroup(group, userID) {
+			return domain.ErrForbidden
+		}
+
+		return u.memberRepo.Delete(ctx, ex, groupID, memberID)
+	})
+}
+
+// addMemberはユーザーの存在とメールアドレス未確認のユーザーの制限を確認してグループメンバーを追加する
+func (u *GroupUseCase) addMember(ctx context.Context, ex domain.Executor, groupID, memberID, addedBy int64) (*domain.GroupMember, error) {
+	user, err := u.userRepo.FindByID(ctx, ex, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("member user not found: %w", err)
+	}
+	if !u.unverifiedUserPolicy.CanBeAssigned(user) {
+		return nil, domain.ErrAssigneeNotVerified
+	}
+
+	member := domain.NewGroupMember(u.clock, groupID, memberID, addedBy)
+	if err := u.memberRepo.Create(ctx, ex, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// toGroupResponseはdomain.GroupとメンバーからGroupResponseを作成
+func toGroupResponse(group *domain.Group, members []*domain.GroupMember) *GroupResponse {
+	memberResponses := make([]MemberResponse, len(members))
+	for i, member := range members {
+		memberResponses[i] = MemberResponse{
+			UserID:  member.UserID,
+			AddedBy: member.AddedBy,
+			AddedAt: member.CreatedAt,
+		}
+	}
+
+	return &GroupResponse{
+		ID:        group.ID,
+		Name:      group.Name,
+		CreatedBy: group.CreatedBy,
+		Members:   memberResponses,
+		CreatedAt: group.CreatedAt,
+		UpdatedAt: group.UpdatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package group
+
+import "time"
+
+// CreateGroupRequest はグループ作成のリクエスト
+type CreateGroupRequest struct {
+	Name      string
+	MemberIDs []int64
+}
+
+// UpdateGroupRequest はグループ更新のリクエスト
+type UpdateGroupRequest struct {
+	Name *string
+}
+
+// GroupResponse はグループのレスポンス
+type GroupResponse struct {
+	ID        int64
+	Name      string
+	CreatedBy int64
+	Members   []MemberResponse
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MemberResponse はグループメンバーのレスポンス
+type MemberResponse struct {
+	UserID  int64
+	AddedBy int64
+	AddedAt time.Time
+}
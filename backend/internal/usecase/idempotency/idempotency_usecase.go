@@ -0,0 +1,106 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// IdempotencyUseCaseはIdempotency-Keyヘッダーによるリクエストの重複実行の防止を提供する
+type IdempotencyUseCase struct {
+	repo      domain.IdempotencyRepository
+	txManager domain.TxManager
+	clock     domain.Clock
+	ttl       time.Duration
+}
+
+// NewIdempotencyUseCaseで新しいIdempotencyUseCaseを作成
+// ttlは処理結果を保存し、リトライに対して再送する期間
+func NewIdempotencyUseCase(
+	repo domain.IdempotencyRepository,
+	txManager domain.TxManager,
+	clock domain.Clock,
+	ttl time.Duration,
+) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		repo:      repo,
+		txManager: txManager,
+		clock:     clock,
+		ttl:       ttl,
+	}
+}
+
+// Beginは冪等キーでリクエストの処理を開始する
+// 同じキーで処理済みのリクエストがある場合はその記録を返し（呼び出し側は保存されたレスポンスを再送する）、
+// 初めてのリクエストの場合はキーを処理中として登録してnilを返す
+// 内容の異なるリクエストでキーを再利用した場合はErrIdempotencyKeyReused、処理中の場合はErrIdempotencyKeyInUseを返す
+func (u *IdempotencyUseCase) Begin(ctx context.Context, userID int64, key, fingerprint string) (*domain.IdempotencyRecord, error) {
+	record, err := domain.NewIdempotencyRecord(u.clock, userID, key, fingerprint, u.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored *domain.IdempotencyRecord
+	err = u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		existing, err := u.repo.FindByKey(ctx, ex, userID, key)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+		case err != nil:
+			return err
+		case existing.IsExpired(record.CreatedAt):
+			// 有効期限が切れたキーは新しいリクエストとして扱う
+			if err := u.repo.Delete(ctx, ex, userID, key); err != nil {
+				return err
+			}
+		default:
+			if err := existing.Match(fingerprint); err != nil {
+				return err
+			}
+			stored = existing
+			return nil
+		}
+
+		return u.repo.Create(ctx, ex, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// Completeは処理が終わったリクエストのレスポンスを保存する
+func (u *IdempotencyUseCase) Complete(ctx context.Context, userID int64, key string, statusCode int, headers map[string]string, body []byte) error {
+	record := &domain.IdempotencyRecord{UserID: userID, Key: key}
+	record.Complete(statusCode, headers, body)
+
+	if err := u.repo.Complete(ctx, u.txManager.AsExecutor(), record); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// CompleteWithResourceは処理が終わったリクエストのレスポンスを保存せず、作成したリソースのIDのみを保存する
+// トークンなどの秘密情報を返すエンドポイントで使い、リトライ時は呼び出し側がIDからレスポンスを作り直す
+func (u *IdempotencyUseCase) CompleteWithResource(ctx context.Context, userID int64, key string, statusCode int, resourceID int64) error {
+	record := &domain.IdempotencyRecord{UserID: userID, Key: key}
+	record.CompleteWithResource(statusCode, resourceID)
+
+	if err := u.repo.Complete(ctx, u.txManager.AsExecutor(), record); err != nil {
+		return fmt.Errorf("failed to save idempotent resource: %w", err)
+	}
+	return nil
+}
+
+// Releaseは処理に失敗したリクエストのキーを削除し、同じキーでリトライできるようにする
+func (u *IdempotencyUseCase) Release(ctx context.Context, userID int64, key string) error {
+	return u.repo.Delete(ctx, u.txManager.AsExecutor(), userID, key)
+}
+
+// DeleteExpiredは有効期限が切れた記録を削除し、削除した件数を返す
+func (u *IdempotencyUseCase) DeleteExpired(ctx context.Context) (int64, error) {
+	return u.repo.DeleteExpired(ctx, u.txManager.AsExecutor(), u.clock.Now())
+}
@@ -0,0 +1,164 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// SLAUseCaseはSLAポリシーの参照とエスカレーションのユースケースを提供する
+type SLAUseCase struct {
+	policyRepo        domain.SLAPolicyRepository
+	taskRepo          domain.TaskRepository
+	assigneeRepo      domain.TaskAssigneeRepository
+	groupAssigneeRepo domain.TaskGroupAssigneeRepository
+	revisionRepo      domain.TaskRevisionRepository
+	userRepo          domain.UserRepository
+	notifier          domain.Notifier
+	txManager         domain.TxManager
+	clock             domain.Clock
+}
+
+// NewSLAUseCaseで新しいSLAUseCaseを作成
+func NewSLAUseCase(
+	policyRepo domain.SLAPolicyRepository,
+	taskRepo domain.TaskRepository,
+	assigneeRepo domain.TaskAssigneeRepository,
+	groupAssigneeRepo domain.TaskGroupAssigneeRepository,
+	revisionRepo domain.TaskRevisionRepository,
+	userRepo domain.UserRepository,
+	notifier domain.Notifier,
+	txManager domain.TxManager,
+	clock domain.Clock,
+) *SLAUseCase {
+	return &SLAUseCase{
+		policyRepo:        policyRepo,
+		taskRepo:          taskRepo,
+		assigneeRepo:      assigneeRepo,
+		groupAssigneeRepo: groupAssigneeRepo,
+		revisionRepo:      revisionRepo,
+		userRepo:          userRepo,
+		notifier:          notifier,
+		txManager:         txManager,
+		clock:             clock,
+	}
+}
+
+// ListPoliciesはSLAポリシー一覧を取得
+func (u *SLAUseCase) ListPolicies(ctx context.Context) ([]SLAPolicyResponse, error) {
+	policies, err := u.policyRepo.FindAll(ctx, u.txManager.AsExecutor())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sla policies: %w", err)
+	}
+
+	responses := make([]SLAPolicyResponse, len(policies))
+	for i, policy := range policies {
+		responses[i] = SLAPolicyResponse{
+			Priority:              policy.Priority,
+			StartWithinMinutes:    int(policy.StartWithin / time.Minute),
+			ResolveWithinMinutes:  int(policy.ResolveWithin / time.Minute),
+			EscalateBeforeMinutes: int(policy.EscalateBefore / time.Minute),
+			EscalationAction:      string(policy.EscalationAction),
+		}
+	}
+	return responses, nil
+}
+
+// EscalateTasksはSLA違反が近い（または違反した）未完了タスクをエスカレーションする
+// タスクごとにトランザクションを分け、通知はコミット後に送る（通知の失敗はログのみ）
+func (u *SLAUseCase) EscalateTasks(ctx context.Context) (*EscalationResult, error) {
+	executor := u.txManager.AsExecutor()
+
+	policies, err := u.policyRepo.FindAll(ctx, executor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sla policies: %w", err)
+	}
+	policyByPriority := make(map[int]*domain.SLAPolicy, len(policies))
+	priorities := make([]int, 0, len(policies))
+	for _, policy := range policies {
+		policyByPriority[policy.Priority] = policy
+		priorities = append(priorities, policy.Priority)
+	}
+
+	candidates, err := u.taskRepo.ListUnescalatedOpen(ctx, executor, priorities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list escalation candidates: %w", err)
+	}
+
+	result := &EscalationResult{}
+	for _, candidate := range candidates {
+		var escalated *domain.Task
+		var status domain.SLAStatus
+		var policy *domain.SLAPolicy
+
+		// 一覧取得後に更新されている可能性があるため、トランザクション内でロックして再取得して判定する
+		err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+			task, err := u.taskRepo.FindByIDForUpdate(ctx, ex, candidate.ID)
+			if err != nil {
+				return err
+			}
+			policy = policyByPriority[task.Priority]
+			now := u.clock.Now()
+			if policy == nil || !policy.NeedsEscalation(task, now) {
+				return nil
+			}
+			status = policy.Evaluate(task, now)
+			task.Escalate(u.clock, policy.EscalationAction)
+			if err := u.taskRepo.Update(ctx, ex, task); err != nil {
+				return fmt.Errorf("failed to update task: %w", err)
+			}
+			if err := u.recordRevision(ctx, ex, task); err != nil {
+				return err
+			}
+			escalated = task
+			return nil
+		})
+		if errors.Is(err, domain.ErrTaskNotFound) {
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to escalate task %d: %w", candidate.ID, err)
+		}
+		if escalated == nil {
+			continue
+		}
+		result.Escalated++
+
+		if !policy.EscalationAction.NotifiesOwner() {
+			continue
+		}
+		owner, err := u.userRepo.FindByID(ctx, executor, escalated.OwnerID)
+		if err != nil {
+			log.Printf("failed to find owner of task %d: %v", escalated.ID, err)
+			continue
+		}
+		if err := u.notifier.NotifySLAEscalation(ctx, owner, escalated, status); err != nil {
+			log.Printf("failed to notify sla escalation of task %d: %v", escalated.ID, err)
+			continue
+		}
+		result.Notified++
+	}
+
+	return result, nil
+}
+
+// recordRevisionはエスカレーションしたタスクの内容をシステムによる変更のリビジョンとして記録
+func (u *SLAUseCase) recordRevision(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	assignees, err := u.assigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find assignees: %w", err)
+	}
+	groupAssignees, err := u.groupAssigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find group assignees: %w", err)
+	}
+	revision := domain.NewTaskRevision(u.clock, task, assignees, groupAssignees, nil)
+	if err := u.revisionRepo.Create(ctx, ex, revision); err != nil {
+		return fmt.Errorf("failed to save task revision: %w", err)
+	}
+	return nil
+}
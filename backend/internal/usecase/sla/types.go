@@ -0,0 +1,16 @@
+package sla
+
+// SLAPolicyResponse はSLAポリシーのレスポンス
+type SLAPolicyResponse struct {
+	Priority              int
+	StartWithinMinutes    int
+	ResolveWithinMinutes  int
+	EscalateBeforeMinutes int
+	EscalationAction      string
+}
+
+// EscalationResult はエスカレーション処理の結果
+type EscalationResult struct {
+	Escalated int
+	Notified  int
+}
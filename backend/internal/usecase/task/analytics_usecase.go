@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// GetLeadTimeは期間内に完了したタスクの作成から完了までの時間の統計を取得
+func (u *TaskUseCase) GetLeadTime(ctx context.Context, userID int64, req AnalyticsRequest) (*DurationStatsResponse, error) {
+	flows, r, err := u.loadTaskFlows(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toDurationStatsResponse(r, domain.NewDurationStats(domain.LeadTimes(flows, r))), nil
+}
+
+// GetCycleTimeは期間内に完了したタスクの最初の着手から完了までの時間の統計を取得
+func (u *TaskUseCase) GetCycleTime(ctx context.Context, userID int64, req AnalyticsRequest) (*DurationStatsResponse, error) {
+	flows, r, err := u.loadTaskFlows(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toDurationStatsResponse(r, domain.NewDurationStats(domain.CycleTimes(flows, r))), nil
+}
+
+// GetThroughputは期間内に完了したタスク数を週ごとに取得
+func (u *TaskUseCase) GetThroughput(ctx context.Context, userID int64, req AnalyticsRequest) (*ThroughputResponse, error) {
+	flows, r, err := u.loadTaskFlows(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	throughput := domain.Throughput(flows, r)
+	weeks := make([]WeeklyThroughputResponse, len(throughput))
+	for i, week := range throughput {
+		weeks[i] = WeeklyThroughputResponse{WeekStart: week.WeekStart, Completed: week.Completed}
+	}
+
+	return &ThroughputResponse{From: r.FromDate, To: r.ToDate, Weeks: weeks}, nil
+}
+
+// GetStatusSnapshotsは期間内の日ごとに、その日の終わり時点のステータス別タスク数を取得
+func (u *TaskUseCase) GetStatusSnapshots(ctx context.Context, userID int64, req AnalyticsRequest) (*StatusSnapshotsResponse, error) {
+	flows, r, err := u.loadTaskFlows(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := domain.DailyStatusSnapshots(flows, r)
+	days := make([]StatusSnapshotResponse, len(snapshots))
+	for i, snapshot := range snapshots {
+		counts := make(map[string]int, len(snapshot.Counts))
+		for status, count := range snapshot.Counts {
+			counts[string(status)] = count
+		}
+		days[i] = StatusSnapshotResponse{Date: snapshot.Date, Counts: counts}
+	}
+
+	return &StatusSnapshotsResponse{From: r.FromDate, To: r.ToDate, Days: days}, nil
+}
+
+// loadTaskFlowsはユーザーのタイムゾーンで分析の対象期間を作成し、閲覧可能なタスクの期間の終わりまでの遷移を取得
+func (u *TaskUseCase) loadTaskFlows(ctx context.Context, userID int64, req AnalyticsRequest) ([]*domain.TaskFlow, domain.TaskAnalyticsRange, error) {
+	executor := u.txManager.AsExecutor()
+
+	user, err := u.userRepo.FindByID(ctx, executor, userID)
+	if err != nil {
+		return nil, domain.TaskAnalyticsRange{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	r, err := domain.NewTaskAnalyticsRange(req.From, req.To, user.Location())
+	if err != nil {
+		return nil, domain.TaskAnalyticsRange{}, err
+	}
+
+	transitions, err := u.transitionRepo.FindVisibleByUserID(ctx, executor, userID, r.End())
+	if err != nil {
+		return nil, domain.TaskAnalyticsRange{}, fmt.Errorf("failed to find status transitions: %w", err)
+	}
+
+	return domain.GroupTaskFlows(transitions), r, nil
+}
+
+// toDurationStatsResponseは所要時間の統計をレスポンスに変換
+func toDurationStatsResponse(r domain.TaskAnalyticsRange, stats domain.DurationStats) *DurationStatsResponse {
+	return &DurationStatsResponse{
+		From:    r.FromDate,
+		To:      r.ToDate,
+		Count:   stats.Count,
+		Average: stats.Average,
+		Median:  stats.Median,
+		P85:     stats.P85,
+	}
+}
@@ -0,0 +1,252 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// maxBulkTasksは一括操作で一度に指定できるタスク数の上限
+const maxBulkTasks = 100
+
+// bulkPlanは検証済みのタスクごとの変更内容
+type bulkPlan struct {
+	task            *domain.Task
+	previousStatus  domain.TaskStatus
+	addAssignees    []*domain.TaskAssignee
+	removeAssignees []int64
+}
+
+// BulkUpdateTasksは複数のタスクにステータス・優先度・期日・アサインの変更または削除を適用
+// 権限チェックとドメインの検証をタスクごとに行ってから書き込むため、検証に失敗したタスクは結果にエラーとして返す
+// atomicモードでは1トランザクションで適用し、1件でも失敗すると何も書き込まない
+// best_effortモードではタスクごとのトランザクションで適用し、書き込み時の失敗（同時更新による競合など）もそのタスクの結果として返す
+func (u *TaskUseCase) BulkUpdateTasks(ctx context.Context, userID int64, req BulkTaskRequest) (*BulkTaskResponse, error) {
+	taskIDs, err := validateBulkRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 追加するアサイン先ユーザーが存在し、アサインできるか確認
+	for _, assigneeID := range req.AddAssigneeIDs {
+		if err := u.checkAssignable(ctx, u.txManager.AsExecutor(), assigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Mode == BulkModeBestEffort {
+		return u.bulkUpdateEach(ctx, userID, taskIDs, req), nil
+	}
+
+	var response *BulkTaskResponse
+
+	err = u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		results := make([]BulkTaskResult, len(taskIDs))
+		plans := make([]*bulkPlan, len(taskIDs))
+		failed := 0
+		for i, taskID := range taskIDs {
+			results[i].TaskID = taskID
+			plans[i], err = u.planBulkChange(ctx, ex, userID, taskID, req)
+			if err != nil {
+				results[i].Err = err
+				failed++
+			}
+		}
+
+		response = &BulkTaskResponse{Mode: req.Mode, Results: results, Failed: failed}
+
+		if failed > 0 {
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = domain.ErrBulkAborted
+					response.Failed++
+				}
+			}
+			return nil
+		}
+
+		for i, plan := range plans {
+			if err := u.applyBulkPlan(ctx, ex, userID, plan, req.Delete); err != nil {
+				return fmt.Errorf("failed to apply bulk change to task %d: %w", plan.task.ID, err)
+			}
+			results[i].Success = true
+			response.Succeeded++
+		}
+		response.Applied = response.Succeeded > 0
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// bulkUpdateEachはbest_effortモードの一括操作をタスクごとのトランザクションで適用する
+// 1件の失敗で他のタスクの変更がロールバックされないよう、検証から書き込みまでをタスク単位で行う
+func (u *TaskUseCase) bulkUpdateEach(ctx context.Context, userID int64, taskIDs []int64, req BulkTaskRequest) *BulkTaskResponse {
+	response := &BulkTaskResponse{Mode: req.Mode, Results: make([]BulkTaskResult, len(taskIDs))}
+
+	for i, taskID := range taskIDs {
+		result := &response.Results[i]
+		result.TaskID = taskID
+
+		result.Err = u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+			plan, err := u.planBulkChange(ctx, ex, userID, taskID, req)
+			if err != nil {
+				return err
+			}
+			return u.applyBulkPlan(ctx, ex, userID, plan, req.Delete)
+		})
+		if result.Err != nil {
+			response.Failed++
+			continue
+		}
+		result.Success = true
+		response.Succeeded++
+	}
+	response.Applied = response.Succeeded > 0
+
+	return response
+}
+
+// validateBulkRequestはリクエストを検証し、重複を除いたタスクIDを返す
+func validateBulkRequest(req *BulkTaskRequest) ([]int64, error) {
+	if req.Mode == "" {
+		req.Mode = BulkModeAtomic
+	}
+	if req.Mode != BulkModeAtomic && req.Mode != BulkModeBestEffort {
+		return nil, domain.ErrInvalidBulkRequest
+	}
+
+	hasUpdate := req.Status != nil || req.Priority != nil || req.DueDate != nil ||
+		len(req.AddAssigneeIDs) > 0 || len(req.RemoveAssigneeIDs) > 0
+	// 削除と更新は同時に指定できない
+	if hasUpdate == req.Delete {
+		return nil, domain.ErrInvalidBulkRequest
+	}
+
+	// 同じユーザーの追加と削除は同時に指定できない
+	removing := make(map[int64]bool, len(req.RemoveAssigneeIDs))
+	for _, assigneeID := range req.RemoveAssigneeIDs {
+		removing[assigneeID] = true
+	}
+	for _, assigneeID := range req.AddAssigneeIDs {
+		if removing[assigneeID] {
+			return nil, domain.ErrInvalidBulkRequest
+		}
+	}
+
+	seen := make(map[int64]bool, len(req.TaskIDs))
+	taskIDs := make([]int64, 0, len(req.TaskIDs))
+	for _, taskID := range req.TaskIDs {
+		if !seen[taskID] {
+			seen[taskID] = true
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	if len(taskIDs) == 0 || len(taskIDs) > maxBulkTasks {
+		return nil, domain.ErrInvalidBulkRequest
+	}
+
+	return taskIDs, nil
+}
+
+// planBulkChangeはタスクの権限チェックと変更内容の検証を行う（書き込みは行わない）
+// 読み込んだバージョンで書き込むため、タスクはトランザクションが終わるまでロックする
+func (u *TaskUseCase) planBulkChange(ctx context.Context, ex domain.Executor, userID, taskID int64, req BulkTaskRequest) (*bulkPlan, error) {
+	task, err := u.taskRepo.FindByIDForUpdate(ctx, ex, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 権限チェック（オーナーのみ更新・削除可能）
+	if !task.IsOwner(userID) {
+		return nil, domain.ErrForbidden
+	}
+
+	plan := &bulkPlan{task: task, previousStatus: task.Status}
+	if req.Delete {
+		return plan, nil
+	}
+
+	if req.Status != nil {
+		if err := task.ChangeStatus(u.clock, domain.TaskStatus(*req.Status)); err != nil {
+			return nil, err
+		}
+	}
+	if req.Priority != nil {
+		if err := task.UpdatePriority(u.clock, *req.Priority); err != nil {
+			return nil, err
+		}
+	}
+	if req.DueDate != nil {
+		setDueDate(u.clock, task, req.DueDate, req.DueAllDay)
+		if err := task.ValidateSchedule(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.AddAssigneeIDs) > 0 || len(req.RemoveAssigneeIDs) > 0 {
+		current, err := u.assigneeRepo.FindByTaskID(ctx, ex, taskID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find assignees: %w", err)
+		}
+		assigned := make(map[int64]bool, len(current))
+		for _, assignee := range current {
+			assigned[assignee.UserID] = true
+		}
+
+		// 既にアサイン済みのユーザーの追加、アサインされていないユーザーの削除は何もしない
+		for _, assigneeID := range req.AddAssigneeIDs {
+			if assigned[assigneeID] {
+				continue
+			}
+			assignee, err := domain.NewTaskAssignee(u.clock, taskID, assigneeID, userID)
+			if err != nil {
+				return nil, err
+			}
+			plan.addAssignees = append(plan.addAssignees, assignee)
+			assigned[assigneeID] = true
+		}
+		for _, assigneeID := range req.RemoveAssigneeIDs {
+			if assigned[assigneeID] {
+				plan.removeAssignees = append(plan.removeAssignees, assigneeID)
+				assigned[assigneeID] = false
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// applyBulkPlanは検証済みの変更を書き込む
+func (u *TaskUseCase) applyBulkPlan(ctx context.Context, ex domain.Executor, userID int64, plan *bulkPlan, deleteTask bool) error {
+	if deleteTask {
+		return u.deleteTask(ctx, ex, plan.task)
+	}
+
+	if err := u.taskRepo.Update(ctx, ex, plan.task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	for _, userID := range plan.removeAssignees {
+		if err := u.assigneeRepo.Delete(ctx, ex, plan.task.ID, userID); err != nil {
+			return fmt.Errorf("failed to delete assignee: %w", err)
+		}
+	}
+	for _, assignee := range plan.addAssignees {
+		if err := u.assigneeRepo.Create(ctx, ex, assignee); err != nil {
+			return fmt.Errorf("failed to create assignee: %w", err)
+		}
+	}
+	if err := u.recordRevision(ctx, ex, plan.task, userID); err != nil {
+		return err
+	}
+	if plan.task.Status != plan.previousStatus {
+		return u.recordTransition(ctx, ex, plan.task, &plan.previousStatus, userID)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/markdown"
+)
+
+// renderDescriptionsはタスクの説明（CommonMark）をサニタイズ済みのHTMLに変換してレスポンスに設定する
+// 説明中のタスク参照（#123）は、ユーザーが閲覧可能なタスクのみリンクにする（閲覧可否はまとめて1回で判定）
+func (u *TaskUseCase) renderDescriptions(ctx context.Context, ex domain.Executor, userID int64, responses ...*TaskResponse) error {
+	seen := make(map[int64]bool)
+	var referenced []int64
+	for _, response := range responses {
+		if response.Description == nil {
+			continue
+		}
+		for _, taskID := range markdown.TaskReferences(*response.Description) {
+			if !seen[taskID] {
+				seen[taskID] = true
+				referenced = append(referenced, taskID)
+			}
+		}
+	}
+
+	visibleIDs, err := u.taskRepo.FindVisibleIDs(ctx, ex, userID, referenced)
+	if err != nil {
+		return err
+	}
+	visible := make(map[int64]bool, len(visibleIDs))
+	for _, id := range visibleIDs {
+		visible[id] = true
+	}
+	linkable := func(taskID int64) bool {
+		return visible[taskID]
+	}
+
+	for _, response := range responses {
+		if response.Description == nil {
+			continue
+		}
+		html, err := markdown.Render(*response.Description, linkable)
+		if err != nil {
+			return fmt.Errorf("failed to render description: %w", err)
+		}
+		response.DescriptionHTML = &html
+	}
+	return nil
+}
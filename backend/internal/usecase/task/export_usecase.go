@@ -0,0 +1,93 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// exportPageSizeはエクスポート時に1回で取得するタスク数
+const exportPageSize = 100
+
+// ExportTasksはユーザーが閲覧可能なタスク（ListTasksと同じ条件）をページ単位で取得し、writePageに渡す
+// すべてのタスクをメモリに載せないよう、1ページ分ずつ担当者名を解決して書き出す
+func (u *TaskUseCase) ExportTasks(ctx context.Context, userID int64, writePage func([]ExportTaskRow) error) error {
+	executor := u.txManager.AsExecutor()
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return err
+	}
+
+	// 担当者名はページをまたいでキャッシュする
+	userNames := make(map[int64]string)
+
+	// ページング中にタスクが作成されても重複・欠落しないようカーソルで続きを取得する
+	filter := domain.TaskFilter{SortKey: domain.TaskSortCreatedAt, SortDirection: domain.SortDesc}
+	var after *domain.TaskCursor
+	for {
+		tasks, err := u.taskRepo.ListByUserID(ctx, executor, userID, filter, after, exportPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list tasks: %w", err)
+		}
+
+		rows := make([]ExportTaskRow, len(tasks))
+		for i, task := range tasks {
+			names, err := u.assigneeNames(ctx, executor, task.ID, userNames)
+			if err != nil {
+				return err
+			}
+			dueDate := task.DueDate
+			if dueDate != nil && !task.DueAllDay {
+				dueDate = v.localTime(dueDate)
+			}
+			rows[i] = ExportTaskRow{
+				ID:            task.ID,
+				Title:         task.Title,
+				Description:   task.Description,
+				Status:        string(task.Status),
+				Priority:      task.Priority,
+				StartDate:     v.localTime(task.StartDate),
+				DueDate:       dueDate,
+				DueAllDay:     task.DueAllDay,
+				AssigneeNames: names,
+				CreatedAt:     task.CreatedAt.In(v.loc),
+				UpdatedAt:     task.UpdatedAt.In(v.loc),
+			}
+		}
+
+		if len(rows) > 0 {
+			if err := writePage(rows); err != nil {
+				return err
+			}
+		}
+		if len(tasks) < exportPageSize {
+			return nil
+		}
+		after = domain.NewTaskCursor(filter, tasks[len(tasks)-1])
+	}
+}
+
+// assigneeNamesはタスクの担当者名をUserRepositoryから解決する
+func (u *TaskUseCase) assigneeNames(ctx context.Context, ex domain.Executor, taskID int64, cache map[int64]string) ([]string, error) {
+	assignees, err := u.assigneeRepo.FindByTaskID(ctx, ex, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find assignees: %w", err)
+	}
+
+	names := make([]string, 0, len(assignees))
+	for _, assignee := range assignees {
+		name, ok := cache[assignee.UserID]
+		if !ok {
+			user, err := u.userRepo.FindByID(ctx, ex, assignee.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find assignee user: %w", err)
+			}
+			name = user.Name
+			cache[assignee.UserID] = name
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
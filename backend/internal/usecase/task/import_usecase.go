@@ -0,0 +1,158 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// maxImportRowsは一度にインポートできる最大行数
+const maxImportRows = 1000
+
+// importDateLayoutはインポート時の終日の期日（日付のみ）のフォーマット
+const importDateLayout = "2006-01-02"
+
+// importedTaskは検証済みのインポート対象タスク
+type importedTask struct {
+	task       *domain.Task
+	assigneeID *int64
+}
+
+// ImportTasksはCSV/JSONから読み込んだ行をタスクとして一括登録する
+// すべての行をドメインの検証に通し、1行でもエラーがある場合やdry-runの場合は何も書き込まない
+func (u *TaskUseCase) ImportTasks(ctx context.Context, userID int64, req ImportTasksRequest) (*ImportTasksResponse, error) {
+	if len(req.Rows) == 0 || len(req.Rows) > maxImportRows {
+		return nil, domain.ErrInvalidImport
+	}
+
+	response := &ImportTasksResponse{DryRun: req.DryRun, Total: len(req.Rows), TaskIDs: []int64{}, Errors: []ImportRowError{}}
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		// 同じメールアドレスの検索を繰り返さないようにキャッシュする（見つからない場合はnil）
+		assigneeUsers := make(map[string]*domain.User)
+
+		imported := make([]importedTask, 0, len(req.Rows))
+		for _, row := range req.Rows {
+			item, rowErrors, err := u.buildImportedTask(ctx, ex, userID, row, assigneeUsers)
+			if err != nil {
+				return err
+			}
+			if len(rowErrors) > 0 {
+				response.Errors = append(response.Errors, rowErrors...)
+				continue
+			}
+			imported = append(imported, item)
+		}
+		response.Valid = len(imported)
+
+		if req.DryRun || len(response.Errors) > 0 {
+			return nil
+		}
+
+		for _, item := range imported {
+			if err := u.taskRepo.Create(ctx, ex, item.task); err != nil {
+				return fmt.Errorf("failed to create task: %w", err)
+			}
+			if err := u.searchIndex.Index(ctx, ex, item.task); err != nil {
+				return fmt.Errorf("failed to index task: %w", err)
+			}
+			if item.assigneeID != nil {
+				if _, err := u.assignUsers(ctx, ex, item.task.ID, userID, []int64{*item.assigneeID}, nil); err != nil {
+					return err
+				}
+			}
+			if err := u.recordRevision(ctx, ex, item.task, userID); err != nil {
+				return err
+			}
+			// インポート時に指定したステータスは作成時点のステータスとして記録する
+			if err := u.recordTransition(ctx, ex, item.task, nil, userID); err != nil {
+				return err
+			}
+			response.TaskIDs = append(response.TaskIDs, item.task.ID)
+		}
+		response.Imported = len(imported)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// buildImportedTaskは1行をドメインの検証に通してタスクを組み立てる（書き込みは行わない）
+// 行の値が不正な場合はrowErrorsに、DBエラーなどの場合はerrに返す
+func (u *TaskUseCase) buildImportedTask(ctx context.Context, ex domain.Executor, userID int64, row ImportTaskRow, assigneeUsers map[string]*domain.User) (item importedTask, rowErrors []ImportRowError, err error) {
+	addError := func(field string, e error) {
+		rowErrors = append(rowErrors, ImportRowError{Line: row.Line, Field: field, Message: e.Error()})
+	}
+
+	task, taskErr := domain.NewTask(u.clock, userID, row.Title)
+	if taskErr != nil {
+		addError("title", taskErr)
+		// タイトル以外の項目も検証してまとめて報告する
+		task = &domain.Task{OwnerID: userID, Status: domain.TaskStatusTODO}
+	}
+
+	if description := strings.TrimSpace(row.Description); description != "" {
+		task.UpdateDescription(u.clock, &description)
+	}
+
+	if dueDate := strings.TrimSpace(row.DueDate); dueDate != "" {
+		if parsed, parseErr := time.Parse(importDateLayout, dueDate); parseErr == nil {
+			task.UpdateAllDayDueDate(u.clock, &parsed)
+		} else if parsed, parseErr := time.Parse(time.RFC3339, dueDate); parseErr == nil {
+			task.UpdateDueDate(u.clock, &parsed)
+		} else {
+			addError("dueDate", errors.New("dueDate must be in ISO8601 format (date-time or date)"))
+		}
+	}
+
+	if priority := strings.TrimSpace(row.Priority); priority != "" {
+		value, convErr := strconv.Atoi(priority)
+		if convErr != nil {
+			addError("priority", domain.ErrInvalidPriority)
+		} else {
+			task.Priority = value
+			if validateErr := task.ValidatePriority(); validateErr != nil {
+				addError("priority", validateErr)
+			}
+		}
+	}
+
+	if status := strings.ToUpper(strings.TrimSpace(row.Status)); status != "" && status != string(domain.TaskStatusTODO) {
+		if statusErr := task.ChangeStatus(u.clock, domain.TaskStatus(status)); statusErr != nil {
+			addError("status", domain.ErrInvalidStatus)
+		}
+	}
+
+	if email := strings.ToLower(strings.TrimSpace(row.AssigneeEmail)); email != "" {
+		user, cached := assigneeUsers[email]
+		if !cached {
+			var findErr error
+			user, findErr = u.userRepo.FindByEmail(ctx, ex, email)
+			if findErr != nil && !errors.Is(findErr, domain.ErrUserNotFound) {
+				return importedTask{}, nil, fmt.Errorf("failed to find assignee: %w", findErr)
+			}
+			assigneeUsers[email] = user
+		}
+		switch {
+		case user == nil:
+			addError("assigneeEmail", domain.ErrUserNotFound)
+		case !u.unverifiedUserPolicy.CanBeAssigned(user):
+			addError("assigneeEmail", domain.ErrAssigneeNotVerified)
+		default:
+			item.assigneeID = &user.ID
+		}
+	}
+
+	item.task = task
+	return item, rowErrors, nil
+}
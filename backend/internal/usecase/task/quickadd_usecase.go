@@ -0,0 +1,108 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/quickadd"
+)
+
+// QuickAddTaskは1行の入力からタイトル・期日・優先度・担当者・ラベルを解析し、タスクを作成
+// 日付・時刻はユーザーのタイムゾーンで解決する。メンションはメールアドレス（または@より前の部分）が一致するユーザーとし、
+// 一致するユーザーがいない・複数いる場合は作成せずErrUnresolvedMentionを返す
+// ラベルはタスクに保存する項目がないため、解析結果として返すのみ
+func (u *TaskUseCase) QuickAddTask(ctx context.Context, userID int64, req QuickAddRequest) (*QuickAddResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	user, err := u.userRepo.FindByID(ctx, executor, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	result := quickadd.Parse(req.Input, u.clock.Now().In(user.Location()))
+
+	mentions, err := u.resolveMentions(ctx, executor, result.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QuickAddResponse{
+		Parsed: QuickAddParsedResponse{
+			Title:     result.Title,
+			DueDate:   result.DueDate,
+			DueAllDay: result.DueAllDay,
+			Priority:  result.Priority,
+			Assignees: mentions,
+			Labels:    result.Labels,
+		},
+	}
+
+	if req.DryRun {
+		// 作成時と同じくタイトルを検証する
+		if _, err := domain.NewTask(u.clock, userID, result.Title); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	assigneeIDs := make([]int64, 0, len(mentions))
+	for _, mention := range mentions {
+		if mention.UserID == nil {
+			return nil, domain.ErrUnresolvedMention
+		}
+		assigneeIDs = append(assigneeIDs, *mention.UserID)
+	}
+
+	createReq := CreateTaskRequest{
+		Title:       result.Title,
+		DueDate:     result.DueDate,
+		DueAllDay:   result.DueAllDay,
+		AssigneeIDs: assigneeIDs,
+	}
+	if result.Priority != nil {
+		createReq.Priority = *result.Priority
+	}
+
+	response.Task, err = u.CreateTask(ctx, userID, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// resolveMentionsはメンションをメールアドレス（または@より前の部分）が一致するユーザーに対応付ける
+// アサインできないメールアドレス未確認のユーザーは対象にしない
+func (u *TaskUseCase) resolveMentions(ctx context.Context, ex domain.Executor, mentions []string) ([]MentionResponse, error) {
+	resolved := make([]MentionResponse, len(mentions))
+	if len(mentions) == 0 {
+		return resolved, nil
+	}
+
+	users, err := u.userRepo.FindAll(ctx, ex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users: %w", err)
+	}
+
+	for i, mention := range mentions {
+		resolved[i].Mention = mention
+		var matched []int64
+		for _, user := range users {
+			if !u.unverifiedUserPolicy.CanBeAssigned(user) {
+				continue
+			}
+			email := strings.ToLower(user.Email)
+			localPart, _, _ := strings.Cut(email, "@")
+			if mention == email || mention == localPart {
+				matched = append(matched, user.ID)
+			}
+		}
+		if len(matched) == 1 {
+			resolved[i].UserID = &matched[0]
+		}
+	}
+
+	return resolved, nil
+}
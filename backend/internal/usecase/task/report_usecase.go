@@ -0,0 +1,62 @@
+package task
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// GetWorkloadは閲覧可能なタスクを直接アサインされたユーザーごとに集計した負荷レポートを取得
+// 件数の多い順（同数の場合は期限切れの多い順）に返す
+func (u *TaskUseCase) GetWorkload(ctx context.Context, userID int64, req WorkloadRequest) (*WorkloadResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	user, err := u.userRepo.FindByID(ctx, executor, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// ステータスと期日の範囲以外の条件は使わない
+	filter := domain.TaskFilter{
+		Statuses: req.Filter.Statuses,
+		DueFrom:  req.Filter.DueFrom,
+		DueTo:    req.Filter.DueTo,
+		Now:      u.clock.Now(),
+		Location: user.Location(),
+	}
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = domain.OpenTaskStatuses()
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	workloads, err := u.assigneeRepo.WorkloadByUserID(ctx, executor, userID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate workload: %w", err)
+	}
+
+	slices.SortStableFunc(workloads, func(a, b *domain.AssigneeWorkload) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Overdue, a.Overdue)
+	})
+
+	users := make([]WorkloadUserResponse, len(workloads))
+	for i, workload := range workloads {
+		users[i] = WorkloadUserResponse{
+			UserID:     workload.UserID,
+			Name:       workload.Name,
+			Email:      workload.Email,
+			Total:      workload.Total,
+			ByPriority: workload.ByPriority,
+			Overdue:    workload.Overdue,
+		}
+	}
+
+	return &WorkloadResponse{Users: users}, nil
+}
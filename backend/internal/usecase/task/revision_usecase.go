@@ -0,0 +1,183 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// ListRevisionsはタスクのリビジョン一覧を新しい順に取得（閲覧可能なユーザーのみ）
+func (u *TaskUseCase) ListRevisions(ctx context.Context, userID, taskID int64) ([]*RevisionResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	if _, err := u.findViewableTask(ctx, executor, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := u.revisionRepo.FindByTaskID(ctx, executor, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task revisions: %w", err)
+	}
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*RevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = toRevisionResponse(revision, v)
+	}
+	return responses, nil
+}
+
+// GetRevisionはタスクの指定したリビジョンを取得（閲覧可能なユーザーのみ）
+func (u *TaskUseCase) GetRevision(ctx context.Context, userID, taskID, revision int64) (*RevisionResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	if _, err := u.findViewableTask(ctx, executor, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	found, err := u.revisionRepo.FindByRevision(ctx, executor, taskID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRevisionResponse(found, v), nil
+}
+
+// DiffRevisionsはタスクのリビジョンfromからtoへの項目ごとの変更を取得（閲覧可能なユーザーのみ）
+func (u *TaskUseCase) DiffRevisions(ctx context.Context, userID, taskID, from, to int64) (*RevisionDiffResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	if _, err := u.findViewableTask(ctx, executor, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	fromRevision, err := u.revisionRepo.FindByRevision(ctx, executor, taskID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := u.revisionRepo.FindByRevision(ctx, executor, taskID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffTaskRevisions(fromRevision, toRevision)
+	response := &RevisionDiffResponse{From: from, To: to, Changes: make([]FieldChangeResponse, len(changes))}
+	for i, change := range changes {
+		response.Changes[i] = FieldChangeResponse{
+			Field: string(change.Field),
+			From:  v.localValue(change.From),
+			To:    v.localValue(change.To),
+		}
+	}
+	return response, nil
+}
+
+// RevertTaskはタスクを指定したリビジョンの内容（アサインを含む）に戻す
+// 通常の更新（UpdateTask）と同じ権限チェック・バリデーションを行い、新しいリビジョンとして記録する
+func (u *TaskUseCase) RevertTask(ctx context.Context, userID, taskID, revision int64, expectedVersion *int64) (*TaskResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	current, err := u.findViewableTask(ctx, executor, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.revisionRepo.FindByRevision(ctx, executor, taskID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if target.Description != nil {
+		description = *target.Description
+	}
+	req := UpdateTaskRequest{
+		Title:            &target.Title,
+		Description:      &description,
+		StartDate:        target.StartDate,
+		ClearStartDate:   target.StartDate == nil,
+		DueDate:          target.DueDate,
+		DueAllDay:        target.DueAllDay,
+		ClearDueDate:     target.DueDate == nil,
+		Priority:         &target.Priority,
+		AssigneeIDs:      append([]int64{}, target.AssigneeIDs...),
+		AssigneeGroupIDs: append([]int64{}, target.AssigneeGroupIDs...),
+		ExpectedVersion:  expectedVersion,
+	}
+	// ステータスは変わる場合のみ遷移させる（同じステータスへの遷移は不正な遷移になるため）
+	if target.Status != current.Status {
+		status := string(target.Status)
+		req.Status = &status
+	}
+
+	return u.UpdateTask(ctx, userID, taskID, req)
+}
+
+// findViewableTaskはユーザーが閲覧可能なタスクを取得する（閲覧できない場合は存在を隠蔽する）
+func (u *TaskUseCase) findViewableTask(ctx context.Context, ex domain.Executor, userID, taskID int64) (*domain.Task, error) {
+	task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+	if err != nil {
+		return nil, err
+	}
+	canView, err := u.canViewTask(ctx, ex, task, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, domain.ErrTaskNotFound
+	}
+	return task, nil
+}
+
+// toRevisionResponseはリビジョンをレスポンスに変換する（日時は閲覧者のタイムゾーン、終日の期日はそのまま）
+func toRevisionResponse(revision *domain.TaskRevision, v viewer) *RevisionResponse {
+	dueDate := revision.DueDate
+	if dueDate != nil && !revision.DueAllDay {
+		dueDate = v.localTime(dueDate)
+	}
+	return &RevisionResponse{
+		Revision:         revision.Revision,
+		Title:            revision.Title,
+		Description:      revision.Description,
+		StartDate:        v.localTime(revision.StartDate),
+		DueDate:          dueDate,
+		DueAllDay:        revision.DueAllDay,
+		Status:           string(revision.Status),
+		Priority:         revision.Priority,
+		AssigneeIDs:      revision.AssigneeIDs,
+		AssigneeGroupIDs: revision.AssigneeGroupIDs,
+		ChangedBy:        revision.ChangedBy,
+		CreatedAt:        revision.CreatedAt.In(v.loc),
+	}
+}
+
+// localValueは差分の値のうち日時を閲覧者のタイムゾーンに変換する（終日の期日はそのまま）
+func (v viewer) localValue(value any) any {
+	switch value := value.(type) {
+	case *time.Time:
+		return v.localTime(value)
+	case domain.TaskDueDate:
+		if !value.AllDay {
+			value.Date = v.localTime(value.Date)
+		}
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,86 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/pkg/textsearch"
+)
+
+const (
+	// maxSearchQueryLengthは検索クエリの最大文字数
+	maxSearchQueryLength = 200
+	// maxSearchLimitは検索結果の最大件数
+	maxSearchLimit = 50
+	// descriptionSnippetLengthは説明のスニペットの最大文字数
+	descriptionSnippetLength = 120
+)
+
+// SearchTasksは閲覧可能なタスクをタイトル・説明で全文検索し、関連度の高い順に返す
+func (u *TaskUseCase) SearchTasks(ctx context.Context, userID int64, req SearchTasksRequest) ([]*SearchResult, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" || utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > maxSearchLimit {
+		req.Limit = maxSearchLimit
+	}
+
+	executor := u.txManager.AsExecutor()
+
+	hits, err := u.searchIndex.Search(ctx, executor, userID, query, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := textsearch.Terms(query)
+	results := make([]*SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		task, err := u.taskRepo.FindByID(ctx, executor, hit.TaskID)
+		if err != nil {
+			// 検索後に削除されたタスクは除く
+			if errors.Is(err, domain.ErrTaskNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to find task: %w", err)
+		}
+
+		response, err := u.loadTaskResponse(ctx, executor, task, v)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &SearchResult{
+			Task:           response,
+			Score:          hit.Score,
+			TitleHighlight: textsearch.Highlight(task.Title, terms, 0),
+		}
+		if task.Description != nil {
+			snippet := textsearch.Highlight(*task.Description, terms, descriptionSnippetLength)
+			result.DescriptionHighlight = &snippet
+		}
+		results = append(results, result)
+	}
+
+	responses := make([]*TaskResponse, len(results))
+	for i, result := range results {
+		responses[i] = result.Task
+	}
+	if err := u.renderDescriptions(ctx, executor, userID, responses...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
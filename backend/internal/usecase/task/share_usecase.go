@@ -0,0 +1,101 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// ListSharesはタスクの共有設定一覧を取得（オーナーのみ）
+func (u *TaskUseCase) ListShares(ctx context.Context, userID, taskID int64) ([]ShareResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	task, err := u.taskRepo.FindByID(ctx, executor, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 権限チェック（オーナーのみ共有設定を参照可能）
+	if !domain.CanManageShares(task, userID) {
+		return nil, domain.ErrForbidden
+	}
+
+	shares, err := u.shareRepo.FindByTaskID(ctx, executor, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shares: %w", err)
+	}
+
+	return toShareResponses(shares), nil
+}
+
+// ShareTaskはタスクをユーザーと共有する（共有済みの場合は権限を更新）
+func (u *TaskUseCase) ShareTask(ctx context.Context, userID, taskID int64, req ShareTaskRequest) (*ShareResponse, error) {
+	var response *ShareResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（オーナーのみ共有設定を管理可能）
+		if !domain.CanManageShares(task, userID) {
+			return domain.ErrForbidden
+		}
+
+		// 共有先ユーザーが存在するか確認
+		if _, err := u.userRepo.FindByID(ctx, ex, req.UserID); err != nil {
+			return fmt.Errorf("share user not found: %w", err)
+		}
+
+		share, err := domain.NewTaskShare(u.clock, task, req.UserID, domain.SharePermission(req.Permission), userID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.shareRepo.Upsert(ctx, ex, share); err != nil {
+			return fmt.Errorf("failed to share task: %w", err)
+		}
+
+		response = &toShareResponses([]*domain.TaskShare{share})[0]
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RevokeShareはタスクの共有を解除する
+func (u *TaskUseCase) RevokeShare(ctx context.Context, userID, taskID, targetUserID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（オーナーのみ共有設定を管理可能）
+		if !domain.CanManageShares(task, userID) {
+			return domain.ErrForbidden
+		}
+
+		return u.shareRepo.Delete(ctx, ex, taskID, targetUserID)
+	})
+}
+
+// toShareResponsesはdomain.TaskShareのスライスをShareResponseのスライスに変換
+func toShareResponses(shares []*domain.TaskShare) []ShareResponse {
+	responses := make([]ShareResponse, len(shares))
+	for i, share := range shares {
+		responses[i] = ShareResponse{
+			UserID:     share.UserID,
+			Permission: string(share.Permission),
+			SharedBy:   share.SharedBy,
+			SharedAt:   share.UpdatedAt,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// GetStatsはユーザーが閲覧可能なタスクのステータス・優先度ごとの件数などを集計
+// 期限切れ・今週の期日はユーザーのタイムゾーンで判定する
+func (u *TaskUseCase) GetStats(ctx context.Context, userID int64) (*StatsResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	user, err := u.userRepo.FindByID(ctx, executor, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	stats, err := u.taskRepo.StatsByUserID(ctx, executor, userID, domain.NewTaskStatsBounds(u.clock.Now(), user.Location()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate tasks: %w", err)
+	}
+
+	byStatus := make(map[string]int, len(stats.ByStatus))
+	for status, count := range stats.ByStatus {
+		byStatus[string(status)] = count
+	}
+
+	return &StatsResponse{
+		Total:       stats.Total,
+		ByStatus:    byStatus,
+		ByPriority:  stats.ByPriority,
+		Overdue:     stats.Overdue,
+		DueThisWeek: stats.DueThisWeek,
+		Owned:       stats.Owned,
+		Assigned:    stats.Assigned,
+	}, nil
+}
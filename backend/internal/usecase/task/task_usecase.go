@@ -3,78 +3,136 @@ package task
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ryusuke/task_app_layerx/internal/domain"
 )
 
+// maxListLimitはタスク一覧の1ページの最大件数
+const maxListLimit = 100
+
 // TaskUseCaseはタスク管理のユースケースを提供する
 type TaskUseCase struct {
-	taskRepo     domain.TaskRepository
-	assigneeRepo domain.TaskAssigneeRepository
-	userRepo     domain.UserRepository
-	txManager    domain.TxManager
-	clock        domain.Clock
+	taskRepo          domain.TaskRepository
+	assigneeRepo      domain.TaskAssigneeRepository
+	groupAssigneeRepo domain.TaskGroupAssigneeRepository
+	groupRepo         domain.GroupRepository
+	groupMemberRepo   domain.GroupMemberRepository
+	shareRepo         domain.TaskShareRepository
+	dependencyRepo    domain.TaskDependencyRepository
+	slaPolicyRepo     domain.SLAPolicyRepository
+	searchIndex       domain.TaskSearchIndex
+	revisionRepo      domain.TaskRevisionRepository
+	transitionRepo    domain.TaskStatusTransitionRepository
+	userRepo          domain.UserRepository
+	txManager         domain.TxManager
+	clock             domain.Clock
+	// unverifiedUserPolicyはメールアドレス未確認のユーザーをアサインできるかの設定
+	unverifiedUserPolicy domain.UnverifiedUserPolicy
 }
 
 // NewTaskUseCaseで新しいTaskUseCaseを作成
 func NewTaskUseCase(
 	taskRepo domain.TaskRepository,
 	assigneeRepo domain.TaskAssigneeRepository,
+	groupAssigneeRepo domain.TaskGroupAssigneeRepository,
+	groupRepo domain.GroupRepository,
+	groupMemberRepo domain.GroupMemberRepository,
+	shareRepo domain.TaskShareRepository,
+	dependencyRepo domain.TaskDependencyRepository,
+	slaPolicyRepo domain.SLAPolicyRepository,
+	searchIndex domain.TaskSearchIndex,
+	revisionRepo domain.TaskRevisionRepository,
+	transitionRepo domain.TaskStatusTransitionRepository,
 	userRepo domain.UserRepository,
 	txManager domain.TxManager,
 	clock domain.Clock,
+	unverifiedUserPolicy domain.UnverifiedUserPolicy,
 ) *TaskUseCase {
 	return &TaskUseCase{
-		taskRepo:     taskRepo,
-		assigneeRepo: assigneeRepo,
-		userRepo:     userRepo,
-		txManager:    txManager,
-		clock:        clock,
+		taskRepo:             taskRepo,
+		assigneeRepo:         assigneeRepo,
+		groupAssigneeRepo:    groupAssigneeRepo,
+		groupRepo:            groupRepo,
+		groupMemberRepo:      groupMemberRepo,
+		shareRepo:            shareRepo,
+		dependencyRepo:       dependencyRepo,
+		slaPolicyRepo:        slaPolicyRepo,
+		searchIndex:          searchIndex,
+		revisionRepo:         revisionRepo,
+		transitionRepo:       transitionRepo,
+		userRepo:             userRepo,
+		txManager:            txManager,
+		clock:                clock,
+		unverifiedUserPolicy: unverifiedUserPolicy,
 	}
 }
 
 // ListTasksはユーザーに関連するタスク一覧を取得
-func (u *TaskUseCase) ListTasks(ctx context.Context, userID int64, req ListTasksRequest) ([]*TaskResponse, error) {
+// 次のページがある場合はレスポンスのNextCursorに続きの位置を設定する
+func (u *TaskUseCase) ListTasks(ctx context.Context, userID int64, req ListTasksRequest) (*ListTasksResponse, error) {
 	// デフォルト値を設定
 	if req.Limit <= 0 {
 		req.Limit = 20
 	}
-	if req.Offset < 0 {
-		req.Offset = 0
+	if req.Limit > maxListLimit {
+		req.Limit = maxListLimit
 	}
 
 	executor := u.txManager.AsExecutor()
 
-	// ユーザーに関連するタスク一覧を取得
-	tasks, err := u.taskRepo.ListByUserID(ctx, executor, userID, req.Limit, req.Offset)
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 期限切れの判定はレスポンスのoverdueと同じく閲覧者のタイムゾーンで行う
+	filter := req.Filter
+	filter.Now = v.now
+	filter.Location = v.loc
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if req.After != nil {
+		if err := req.After.Validate(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	// ユーザーに関連するタスク一覧を取得（次のページの有無を判定するため1件多く取得する）
+	tasks, err := u.taskRepo.ListByUserID(ctx, executor, userID, filter, req.After, req.Limit+1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tasks: %w", err)
 	}
 
+	response := &ListTasksResponse{}
+	if len(tasks) > req.Limit {
+		tasks = tasks[:req.Limit]
+		response.NextCursor = domain.NewTaskCursor(filter, tasks[len(tasks)-1])
+	}
+
+	if req.IncludeTotal {
+		total, err := u.taskRepo.CountByUserID(ctx, executor, userID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count tasks: %w", err)
+		}
+		response.Total = &total
+	}
+
 	// レスポンスを作成
-	responses := make([]*TaskResponse, len(tasks))
+	response.Tasks = make([]*TaskResponse, len(tasks))
 	for i, task := range tasks {
 		// タスクに関連するアサイン一覧を取得
-		assignees, err := u.assigneeRepo.FindByTaskID(ctx, executor, task.ID)
+		response.Tasks[i], err = u.loadTaskResponse(ctx, executor, task, v)
 		if err != nil {
-			return nil, fmt.Errorf("failed to find assignees: %w", err)
-		}
-
-		responses[i] = &TaskResponse{
-			ID:          task.ID,
-			OwnerID:     task.OwnerID,
-			Title:       task.Title,
-			Description: task.Description,
-			DueDate:     task.DueDate,
-			Status:      string(task.Status),
-			Priority:    task.Priority,
-			Assignees:   toAssigneeResponses(assignees),
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
+			return nil, err
 		}
 	}
+	if err := u.renderDescriptions(ctx, executor, userID, response.Tasks...); err != nil {
+		return nil, err
+	}
 
-	return responses, nil
+	return response, nil
 }
 
 // CreateTaskはタスクを作成
@@ -92,8 +150,14 @@ func (u *TaskUseCase) CreateTask(ctx context.Context, userID int64, req CreateTa
 		if req.Description != nil {
 			task.UpdateDescription(u.clock, req.Description)
 		}
+		if req.StartDate != nil {
+			task.UpdateStartDate(u.clock, req.StartDate)
+		}
 		if req.DueDate != nil {
-			task.UpdateDueDate(u.clock, req.DueDate)
+			setDueDate(u.clock, task, req.DueDate, req.DueAllDay)
+		}
+		if err := task.ValidateSchedule(); err != nil {
+			return err
 		}
 		if req.Priority != 0 {
 			task.Priority = req.Priority
@@ -106,44 +170,35 @@ func (u *TaskUseCase) CreateTask(ctx context.Context, userID int64, req CreateTa
 		if err := u.taskRepo.Create(ctx, ex, task); err != nil {
 			return fmt.Errorf("failed to create task: %w", err)
 		}
+		if err := u.searchIndex.Index(ctx, ex, task); err != nil {
+			return fmt.Errorf("failed to index task: %w", err)
+		}
 
 		// アサイン処理
-		assignees := make([]*domain.TaskAssignee, 0)
-		for _, assigneeID := range req.AssigneeIDs {
-			// アサイン先ユーザーが存在するか確認
-			_, err := u.userRepo.FindByID(ctx, ex, assigneeID)
-			if err != nil {
-				return fmt.Errorf("assignee user not found: %w", err)
-			}
-
-			assignee := &domain.TaskAssignee{
-				TaskID:     task.ID,
-				UserID:     assigneeID,
-				AssignedBy: userID,
-				CreatedAt:  u.clock.Now(),
-			}
-
-			if err := u.assigneeRepo.Create(ctx, ex, assignee); err != nil {
-				return fmt.Errorf("failed to create assignee: %w", err)
-			}
+		assignees, err := u.assignUsers(ctx, ex, task.ID, userID, req.AssigneeIDs, nil)
+		if err != nil {
+			return err
+		}
+		groupAssignees, err := u.assignGroups(ctx, ex, task.ID, userID, req.AssigneeGroupIDs)
+		if err != nil {
+			return err
+		}
 
-			assignees = append(assignees, assignee)
+		if err := u.saveRevision(ctx, ex, task, assignees, groupAssignees, userID); err != nil {
+			return err
+		}
+		if err := u.recordTransition(ctx, ex, task, nil, userID); err != nil {
+			return err
 		}
 
-		response = &TaskResponse{
-			ID:          task.ID,
-			OwnerID:     task.OwnerID,
-			Title:       task.Title,
-			Description: task.Description,
-			DueDate:     task.DueDate,
-			Status:      string(task.Status),
-			Priority:    task.Priority,
-			Assignees:   toAssigneeResponses(assignees),
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
+		v, err := u.viewerFor(ctx, ex, userID)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		response = toTaskResponse(task, assignees, groupAssignees, v)
+
+		return u.renderDescriptions(ctx, ex, userID, response)
 	})
 
 	if err != nil {
@@ -163,46 +218,30 @@ func (u *TaskUseCase) GetTask(ctx context.Context, userID, taskID int64) (*TaskR
 		return nil, err
 	}
 
-	// 権限チェック（オーナーまたはアサイン先のみ）
-	if !task.IsOwner(userID) {
-		// アサイン先かチェック
-		assignees, err := u.assigneeRepo.FindByTaskID(ctx, executor, taskID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find assignees: %w", err)
-		}
-
-		isAssignee := false
-		for _, assignee := range assignees {
-			if assignee.UserID == userID {
-				isAssignee = true
-				break
-			}
-		}
+	// 権限チェック（オーナー・アサイン先・アサインされたグループのメンバー・共有先のみ）
+	canView, err := u.canViewTask(ctx, executor, task, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		// 閲覧権限がない場合は存在を隠蔽
+		return nil, domain.ErrTaskNotFound
+	}
 
-		if !isAssignee {
-			// オーナーでもアサイン先でもない場合は存在を隠蔽
-			return nil, domain.ErrTaskNotFound
-		}
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// アサイン一覧を取得
-	assignees, err := u.assigneeRepo.FindByTaskID(ctx, executor, taskID)
+	response, err := u.loadTaskResponse(ctx, executor, task, v)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find assignees: %w", err)
+		return nil, err
+	}
+	if err := u.renderDescriptions(ctx, executor, userID, response); err != nil {
+		return nil, err
 	}
 
-	return &TaskResponse{
-		ID:          task.ID,
-		OwnerID:     task.OwnerID,
-		Title:       task.Title,
-		Description: task.Description,
-		DueDate:     task.DueDate,
-		Status:      string(task.Status),
-		Priority:    task.Priority,
-		Assignees:   toAssigneeResponses(assignees),
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
-	}, nil
+	return response, nil
 }
 
 // UpdateTaskはタスクを更新
@@ -210,8 +249,8 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 	var response *TaskResponse
 
 	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
-		// タスクを取得
-		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		// タスクをロックして取得（If-Matchを指定しない更新が、同時の他の更新とバージョンの不一致にならないように）
+		task, err := u.taskRepo.FindByIDForUpdate(ctx, ex, taskID)
 		if err != nil {
 			return err
 		}
@@ -221,6 +260,11 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 			return domain.ErrForbidden
 		}
 
+		// 指定されたバージョンの照合は保存時にリポジトリが行う
+		if req.ExpectedVersion != nil {
+			task.Version = *req.ExpectedVersion
+		}
+
 		// 各項目を更新
 		if req.Title != nil {
 			if err := task.UpdateTitle(u.clock, *req.Title); err != nil {
@@ -232,17 +276,23 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 			task.UpdateDescription(u.clock, req.Description)
 		}
 
-		if req.DueDate != nil {
-			task.UpdateDueDate(u.clock, req.DueDate)
+		if req.StartDate != nil || req.ClearStartDate {
+			task.UpdateStartDate(u.clock, req.StartDate)
+		}
+
+		if req.DueDate != nil || req.ClearDueDate {
+			setDueDate(u.clock, task, req.DueDate, req.DueAllDay)
+		}
+
+		if err := task.ValidateSchedule(); err != nil {
+			return err
 		}
 
+		previousStatus := task.Status
 		if req.Status != nil {
-			newStatus := domain.TaskStatus(*req.Status)
-			if err := task.ValidateStatusTransaction(newStatus); err != nil {
+			if err := task.ChangeStatus(u.clock, domain.TaskStatus(*req.Status)); err != nil {
 				return err
 			}
-			task.Status = newStatus
-			task.UpdatedAt = u.clock.Now()
 		}
 
 		if req.Priority != nil {
@@ -255,35 +305,32 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 		if err := u.taskRepo.Update(ctx, ex, task); err != nil {
 			return fmt.Errorf("failed to update task: %w", err)
 		}
+		if err := u.searchIndex.Index(ctx, ex, task); err != nil {
+			return fmt.Errorf("failed to index task: %w", err)
+		}
 
 		// アサインを更新（指定されている場合）
 		var assignees []*domain.TaskAssignee
 		if req.AssigneeIDs != nil {
+			// 既にアサインされているユーザーはアサインし直すだけなので、未確認ユーザーの制限を適用しない
+			current, err := u.assigneeRepo.FindByTaskID(ctx, ex, taskID)
+			if err != nil {
+				return fmt.Errorf("failed to find assignees: %w", err)
+			}
+			alreadyAssigned := make(map[int64]bool, len(current))
+			for _, assignee := range current {
+				alreadyAssigned[assignee.UserID] = true
+			}
+
 			// 既存のアサインを削除（idempotentな操作）
 			if err := u.assigneeRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
 				return fmt.Errorf("failed to delete assignees: %w", err)
 			}
 
 			// 新しいアサインを作成
-			for _, assigneeID := range req.AssigneeIDs {
-				// アサイン先ユーザーが存在するか確認
-				_, err := u.userRepo.FindByID(ctx, ex, assigneeID)
-				if err != nil {
-					return fmt.Errorf("assignee user not found: %w", err)
-				}
-
-				assignee := &domain.TaskAssignee{
-					TaskID:     taskID,
-					UserID:     assigneeID,
-					AssignedBy: userID,
-					CreatedAt:  u.clock.Now(),
-				}
-
-				if err := u.assigneeRepo.Create(ctx, ex, assignee); err != nil {
-					return fmt.Errorf("failed to create assignee: %w", err)
-				}
-
-				assignees = append(assignees, assignee)
+			assignees, err = u.assignUsers(ctx, ex, taskID, userID, req.AssigneeIDs, alreadyAssigned)
+			if err != nil {
+				return err
 			}
 		} else {
 			// AssigneeIDsが指定されていない場合は既存のアサインを維持
@@ -293,21 +340,42 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 			}
 		}
 
-		// レスポンスを作成
-		response = &TaskResponse{
-			ID:          task.ID,
-			OwnerID:     task.OwnerID,
-			Title:       task.Title,
-			Description: task.Description,
-			DueDate:     task.DueDate,
-			Status:      string(task.Status),
-			Priority:    task.Priority,
-			Assignees:   toAssigneeResponses(assignees),
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
+		// グループアサインを更新（指定されている場合）
+		var groupAssignees []*domain.TaskGroupAssignee
+		if req.AssigneeGroupIDs != nil {
+			if err := u.groupAssigneeRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
+				return fmt.Errorf("failed to delete group assignees: %w", err)
+			}
+
+			groupAssignees, err = u.assignGroups(ctx, ex, taskID, userID, req.AssigneeGroupIDs)
+			if err != nil {
+				return err
+			}
+		} else {
+			groupAssignees, err = u.groupAssigneeRepo.FindByTaskID(ctx, ex, taskID)
+			if err != nil {
+				return fmt.Errorf("failed to find group assignees: %w", err)
+			}
 		}
 
-		return nil
+		if err := u.saveRevision(ctx, ex, task, assignees, groupAssignees, userID); err != nil {
+			return err
+		}
+		if task.Status != previousStatus {
+			if err := u.recordTransition(ctx, ex, task, &previousStatus, userID); err != nil {
+				return err
+			}
+		}
+
+		v, err := u.viewerFor(ctx, ex, userID)
+		if err != nil {
+			return err
+		}
+
+		// レスポンスを作成
+		response = toTaskResponse(task, assignees, groupAssignees, v)
+
+		return u.renderDescriptions(ctx, ex, userID, response)
 	})
 
 	if err != nil {
@@ -318,10 +386,11 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, userID, taskID int64, req
 }
 
 // DeleteTaskはタスクを削除
-func (u *TaskUseCase) DeleteTask(ctx context.Context, userID, taskID int64) error {
+// expectedVersionを指定した場合、タスクのバージョンが一致するときだけ削除する
+func (u *TaskUseCase) DeleteTask(ctx context.Context, userID, taskID int64, expectedVersion *int64) error {
 	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
-		// タスクを取得
-		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		// タスクをロックして取得（expectedVersionを指定しない削除が、同時の他の更新とバージョンの不一致にならないように）
+		task, err := u.taskRepo.FindByIDForUpdate(ctx, ex, taskID)
 		if err != nil {
 			return err
 		}
@@ -331,29 +400,290 @@ func (u *TaskUseCase) DeleteTask(ctx context.Context, userID, taskID int64) erro
 			return domain.ErrForbidden
 		}
 
-		// アサインを削除
-		if err := u.assigneeRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
-			return fmt.Errorf("failed to delete assignees: %w", err)
+		if expectedVersion != nil {
+			task.Version = *expectedVersion
 		}
 
-		// タスクを削除
-		now := u.clock.Now()
-		if err := u.taskRepo.Delete(ctx, ex, taskID, now); err != nil {
-			return fmt.Errorf("failed to delete task: %w", err)
+		return u.deleteTask(ctx, ex, task)
+	})
+}
+
+// deleteTaskはタスクと関連するアサイン・共有設定・依存関係を削除
+// タスクのバージョンが保存されているものと一致しない場合はErrTaskVersionMismatchを返す
+func (u *TaskUseCase) deleteTask(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	taskID := task.ID
+
+	// アサインを削除
+	if err := u.assigneeRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
+		return fmt.Errorf("failed to delete assignees: %w", err)
+	}
+	if err := u.groupAssigneeRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
+		return fmt.Errorf("failed to delete group assignees: %w", err)
+	}
+
+	// 共有設定を削除
+	if err := u.shareRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
+		return fmt.Errorf("failed to delete shares: %w", err)
+	}
+
+	// 依存関係を削除
+	if err := u.dependencyRepo.DeleteByTaskID(ctx, ex, taskID); err != nil {
+		return fmt.Errorf("failed to delete dependencies: %w", err)
+	}
+
+	// タスクを削除
+	now := u.clock.Now()
+	if err := u.taskRepo.Delete(ctx, ex, taskID, task.Version, now); err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	if err := u.searchIndex.Remove(ctx, ex, taskID); err != nil {
+		return fmt.Errorf("failed to remove task from search index: %w", err)
+	}
+
+	return nil
+}
+
+// canViewTaskはユーザーがタスクを閲覧できるかを、アサイン・グループ・共有設定を取得して判定する
+func (u *TaskUseCase) canViewTask(ctx context.Context, ex domain.Executor, task *domain.Task, userID int64) (bool, error) {
+	if task.IsOwner(userID) {
+		return true, nil
+	}
+
+	assignees, err := u.assigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find assignees: %w", err)
+	}
+	groupAssignees, err := u.groupAssigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find group assignees: %w", err)
+	}
+	userGroupIDs, err := u.groupMemberRepo.FindGroupIDsByUserID(ctx, ex, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find user groups: %w", err)
+	}
+	shares, err := u.shareRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find shares: %w", err)
+	}
+
+	return domain.CanViewTask(task, assignees, groupAssignees, userGroupIDs, shares, userID), nil
+}
+
+// assignUsersはタスクにユーザーをアサインする
+// alreadyAssignedに含まれるユーザー（更新前からアサインされていたユーザー）には未確認ユーザーの制限を適用しない
+func (u *TaskUseCase) assignUsers(ctx context.Context, ex domain.Executor, taskID, assignedBy int64, userIDs []int64, alreadyAssigned map[int64]bool) ([]*domain.TaskAssignee, error) {
+	assignees := make([]*domain.TaskAssignee, 0, len(userIDs))
+	for _, assigneeID := range userIDs {
+		if alreadyAssigned[assigneeID] {
+			// アサイン先ユーザーが存在するか確認
+			if _, err := u.userRepo.FindByID(ctx, ex, assigneeID); err != nil {
+				return nil, fmt.Errorf("assignee user not found: %w", err)
+			}
+		} else if err := u.checkAssignable(ctx, ex, assigneeID); err != nil {
+			return nil, err
 		}
 
+		assignee, err := domain.NewTaskAssignee(u.clock, taskID, assigneeID, assignedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := u.assigneeRepo.Create(ctx, ex, assignee); err != nil {
+			return nil, fmt.Errorf("failed to create assignee: %w", err)
+		}
+
+		assignees = append(assignees, assignee)
+	}
+	return assignees, nil
+}
+
+// checkAssignableはアサイン先ユーザーが存在し、メールアドレス未確認のユーザーの制限に該当しないことを確認する
+func (u *TaskUseCase) checkAssignable(ctx context.Context, ex domain.Executor, userID int64) error {
+	user, err := u.userRepo.FindByID(ctx, ex, userID)
+	if err != nil {
+		return fmt.Errorf("assignee user not found: %w", err)
+	}
+	if !u.unverifiedUserPolicy.CanBeAssigned(user) {
+		return domain.ErrAssigneeNotVerified
+	}
+	return nil
+}
+
+// assignGroupsはタスクにグループをアサインする
+func (u *TaskUseCase) assignGroups(ctx context.Context, ex domain.Executor, taskID, assignedBy int64, groupIDs []int64) ([]*domain.TaskGroupAssignee, error) {
+	groupAssignees := make([]*domain.TaskGroupAssignee, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		// アサイン先グループが存在するか確認
+		if _, err := u.groupRepo.FindByID(ctx, ex, groupID); err != nil {
+			return nil, fmt.Errorf("assignee group not found: %w", err)
+		}
+
+		groupAssignee := domain.NewTaskGroupAssignee(u.clock, taskID, groupID, assignedBy)
+		if err := u.groupAssigneeRepo.Create(ctx, ex, groupAssignee); err != nil {
+			return nil, fmt.Errorf("failed to create group assignee: %w", err)
+		}
+
+		groupAssignees = append(groupAssignees, groupAssignee)
+	}
+	return groupAssignees, nil
+}
+
+// viewerはレスポンスを受け取るユーザーの表示タイムゾーンと基準時刻、SLA判定に用いる優先度ごとのポリシー
+type viewer struct {
+	loc         *time.Location
+	now         time.Time
+	slaPolicies map[int]*domain.SLAPolicy
+}
+
+// localTimeは時刻をviewerのタイムゾーンに変換
+func (v viewer) localTime(t *time.Time) *time.Time {
+	if t == nil {
 		return nil
-	})
+	}
+	local := t.In(v.loc)
+	return &local
+}
+
+// viewerForはユーザーのタイムゾーン設定とSLAポリシーからviewerを作成
+func (u *TaskUseCase) viewerFor(ctx context.Context, ex domain.Executor, userID int64) (viewer, error) {
+	user, err := u.userRepo.FindByID(ctx, ex, userID)
+	if err != nil {
+		return viewer{}, fmt.Errorf("failed to find viewer: %w", err)
+	}
+	policies, err := u.slaPolicyRepo.FindAll(ctx, ex)
+	if err != nil {
+		return viewer{}, fmt.Errorf("failed to find sla policies: %w", err)
+	}
+	slaPolicies := make(map[int]*domain.SLAPolicy, len(policies))
+	for _, policy := range policies {
+		slaPolicies[policy.Priority] = policy
+	}
+	return viewer{loc: user.Location(), now: u.clock.Now(), slaPolicies: slaPolicies}, nil
+}
+
+// slaResponseはタスクの優先度に対応するSLAの達成状況を作成（SLA対象外の場合はnil）
+func (v viewer) slaResponse(task *domain.Task) *SLAResponse {
+	policy, ok := v.slaPolicies[task.Priority]
+	if !ok {
+		return nil
+	}
+	status := policy.Evaluate(task, v.now)
+	return &SLAResponse{
+		StartDeadline:   status.StartDeadline.In(v.loc),
+		ResolveDeadline: status.ResolveDeadline.In(v.loc),
+		TimeToStart:     status.TimeToStart,
+		TimeToDone:      status.TimeToDone,
+		StartBreached:   status.StartBreached,
+		ResolveBreached: status.ResolveBreached,
+		Breached:        status.Breached(),
+		EscalatedAt:     v.localTime(task.SLAEscalatedAt),
+	}
+}
+
+// setDueDateは終日かどうかに応じて期日を設定
+func setDueDate(clock domain.Clock, task *domain.Task, dueDate *time.Time, allDay bool) {
+	if allDay {
+		task.UpdateAllDayDueDate(clock, dueDate)
+		return
+	}
+	task.UpdateDueDate(clock, dueDate)
+}
+
+// recordRevisionは保存したタスクの現在の内容とアサインを取得してリビジョンとして記録
+func (u *TaskUseCase) recordRevision(ctx context.Context, ex domain.Executor, task *domain.Task, changedBy int64) error {
+	assignees, err := u.assigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find assignees: %w", err)
+	}
+	groupAssignees, err := u.groupAssigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find group assignees: %w", err)
+	}
+	return u.saveRevision(ctx, ex, task, assignees, groupAssignees, changedBy)
+}
+
+// saveRevisionは保存したタスクとアサインをリビジョンとして記録
+func (u *TaskUseCase) saveRevision(ctx context.Context, ex domain.Executor, task *domain.Task, assignees []*domain.TaskAssignee, groupAssignees []*domain.TaskGroupAssignee, changedBy int64) error {
+	revision := domain.NewTaskRevision(u.clock, task, assignees, groupAssignees, &changedBy)
+	if err := u.revisionRepo.Create(ctx, ex, revision); err != nil {
+		return fmt.Errorf("failed to save task revision: %w", err)
+	}
+	return nil
+}
+
+// recordTransitionはタスクの現在のステータスへの遷移を記録（作成時はfromをnilとする）
+func (u *TaskUseCase) recordTransition(ctx context.Context, ex domain.Executor, task *domain.Task, from *domain.TaskStatus, changedBy int64) error {
+	transition := domain.NewTaskStatusTransition(u.clock, task, from, changedBy)
+	if err := u.transitionRepo.Create(ctx, ex, transition); err != nil {
+		return fmt.Errorf("failed to record status transition: %w", err)
+	}
+	return nil
+}
+
+// loadTaskResponseはタスクのアサイン情報を取得してTaskResponseを作成
+func (u *TaskUseCase) loadTaskResponse(ctx context.Context, ex domain.Executor, task *domain.Task, v viewer) (*TaskResponse, error) {
+	assignees, err := u.assigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find assignees: %w", err)
+	}
+	groupAssignees, err := u.groupAssigneeRepo.FindByTaskID(ctx, ex, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group assignees: %w", err)
+	}
+	return toTaskResponse(task, assignees, groupAssignees, v), nil
+}
+
+// toTaskResponseはdomain.TaskとアサインからTaskResponseを作成
+// 時刻はviewerのタイムゾーンに変換する（終日の期日は日付のみのためUTCのまま）
+func toTaskResponse(task *domain.Task, assignees []*domain.TaskAssignee, groupAssignees []*domain.TaskGroupAssignee, v viewer) *TaskResponse {
+	dueDate := task.DueDate
+	if dueDate != nil && !task.DueAllDay {
+		dueDate = v.localTime(dueDate)
+	}
+
+	return &TaskResponse{
+		ID:             task.ID,
+		OwnerID:        task.OwnerID,
+		Title:          task.Title,
+		Description:    task.Description,
+		StartDate:      v.localTime(task.StartDate),
+		DueDate:        dueDate,
+		DueAllDay:      task.DueAllDay,
+		Overdue:        task.IsOverdue(v.now, v.loc),
+		Status:         string(task.Status),
+		Priority:       task.Priority,
+		StartedAt:      v.localTime(task.StartedAt),
+		CompletedAt:    v.localTime(task.CompletedAt),
+		SLA:            v.slaResponse(task),
+		Assignees:      toAssigneeResponses(assignees, v.loc),
+		GroupAssignees: toGroupAssigneeResponses(groupAssignees, v.loc),
+		Version:        task.Version,
+		CreatedAt:      task.CreatedAt.In(v.loc),
+		UpdatedAt:      task.UpdatedAt.In(v.loc),
+	}
 }
 
 // toAssigneeResponsesはdomain.TaskAssigneeのスライスをAssigneeResponseのスライスに変換
-func toAssigneeResponses(assignees []*domain.TaskAssignee) []AssigneeResponse {
+func toAssigneeResponses(assignees []*domain.TaskAssignee, loc *time.Location) []AssigneeResponse {
 	responses := make([]AssigneeResponse, len(assignees))
 	for i, assignee := range assignees {
 		responses[i] = AssigneeResponse{
 			UserID:     assignee.UserID,
 			AssignedBy: assignee.AssignedBy,
-			AssignedAt: assignee.CreatedAt,
+			AssignedAt: assignee.CreatedAt.In(loc),
+		}
+	}
+	return responses
+}
+
+// toGroupAssigneeResponsesはdomain.TaskGroupAssigneeのスライスをGroupAssigneeResponseのスライスに変換
+func toGroupAssigneeResponses(groupAssignees []*domain.TaskGroupAssignee, loc *time.Location) []GroupAssigneeResponse {
+	responses := make([]GroupAssigneeResponse, len(groupAssignees))
+	for i, groupAssignee := range groupAssignees {
+		responses[i] = GroupAssigneeResponse{
+			GroupID:    groupAssignee.GroupID,
+			AssignedBy: groupAssignee.AssignedBy,
+			AssignedAt: groupAssignee.CreatedAt.In(loc),
 		}
 	}
 	return responses
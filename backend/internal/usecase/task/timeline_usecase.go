@@ -0,0 +1,185 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// maxTimelineDaysはタイムラインで一度に取得できる最大日数
+const maxTimelineDays = 366
+
+// GetTimelineは期間と日程が重なる閲覧可能なタスクを、依存関係とともに取得
+// 依存関係は同じタイムライン上に表示されるタスク間のもののみ返す
+func (u *TaskUseCase) GetTimeline(ctx context.Context, userID int64, req TimelineRequest) (*TimelineResponse, error) {
+	if !req.From.Before(req.To) || req.To.Sub(req.From) > maxTimelineDays*24*time.Hour {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	executor := u.txManager.AsExecutor()
+
+	v, err := u.viewerFor(ctx, executor, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 終日の期日はレスポンスのoverdueと同じく閲覧者のタイムゾーンで期間と重なるかを判定する
+	scheduleRange := domain.ScheduleRange{From: req.From, To: req.To, Location: v.loc}
+	tasks, err := u.taskRepo.ListScheduledByUserID(ctx, executor, userID, scheduleRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled tasks: %w", err)
+	}
+
+	taskIDs := make([]int64, len(tasks))
+	onTimeline := make(map[int64]bool, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+		onTimeline[task.ID] = true
+	}
+
+	dependencies, err := u.dependencyRepo.FindByTaskIDs(ctx, executor, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dependencies: %w", err)
+	}
+	dependsOn := make(map[int64][]int64, len(dependencies))
+	for _, dep := range dependencies {
+		if onTimeline[dep.DependsOnTaskID] {
+			dependsOn[dep.TaskID] = append(dependsOn[dep.TaskID], dep.DependsOnTaskID)
+		}
+	}
+
+	items := make([]TimelineTaskResponse, len(tasks))
+	for i, task := range tasks {
+		dueDate := task.DueDate
+		if dueDate != nil && !task.DueAllDay {
+			dueDate = v.localTime(dueDate)
+		}
+		deps := dependsOn[task.ID]
+		if deps == nil {
+			deps = []int64{}
+		}
+		items[i] = TimelineTaskResponse{
+			ID:        task.ID,
+			Title:     task.Title,
+			Status:    string(task.Status),
+			Priority:  task.Priority,
+			StartDate: v.localTime(task.StartDate),
+			DueDate:   dueDate,
+			DueAllDay: task.DueAllDay,
+			Overdue:   task.IsOverdue(v.now, v.loc),
+			DependsOn: deps,
+		}
+	}
+
+	return &TimelineResponse{
+		From:  req.From.In(v.loc),
+		To:    req.To.In(v.loc),
+		Tasks: items,
+	}, nil
+}
+
+// AddDependencyはタスクに依存先タスクを追加する（オーナーのみ）
+func (u *TaskUseCase) AddDependency(ctx context.Context, userID, taskID int64, req AddDependencyRequest) (*DependencyResponse, error) {
+	var response *DependencyResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（オーナーのみ依存関係を変更可能）
+		if !task.IsOwner(userID) {
+			return domain.ErrForbidden
+		}
+
+		dependency, err := domain.NewTaskDependency(u.clock, taskID, req.DependsOnTaskID, userID)
+		if err != nil {
+			return err
+		}
+
+		// 依存先タスクは閲覧可能なもののみ（閲覧できない場合は存在を隠蔽）
+		dependsOnTask, err := u.taskRepo.FindByID(ctx, ex, req.DependsOnTaskID)
+		if err != nil {
+			return err
+		}
+		canView, err := u.canViewTask(ctx, ex, dependsOnTask, userID)
+		if err != nil {
+			return err
+		}
+		if !canView {
+			return domain.ErrTaskNotFound
+		}
+
+		// 循環チェック
+		reachable, err := u.collectDependencies(ctx, ex, req.DependsOnTaskID)
+		if err != nil {
+			return err
+		}
+		if domain.WouldCreateDependencyCycle(reachable, taskID, req.DependsOnTaskID) {
+			return domain.ErrDependencyCycle
+		}
+
+		if err := u.dependencyRepo.Create(ctx, ex, dependency); err != nil {
+			return fmt.Errorf("failed to create dependency: %w", err)
+		}
+
+		response = &DependencyResponse{
+			TaskID:          dependency.TaskID,
+			DependsOnTaskID: dependency.DependsOnTaskID,
+			CreatedBy:       dependency.CreatedBy,
+			CreatedAt:       dependency.CreatedAt,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RemoveDependencyはタスクの依存関係を削除する（オーナーのみ）
+func (u *TaskUseCase) RemoveDependency(ctx context.Context, userID, taskID, dependsOnTaskID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		task, err := u.taskRepo.FindByID(ctx, ex, taskID)
+		if err != nil {
+			return err
+		}
+
+		// 権限チェック（オーナーのみ依存関係を変更可能）
+		if !task.IsOwner(userID) {
+			return domain.ErrForbidden
+		}
+
+		return u.dependencyRepo.Delete(ctx, ex, taskID, dependsOnTaskID)
+	})
+}
+
+// collectDependenciesはタスクから辿れるすべての依存関係を取得
+func (u *TaskUseCase) collectDependencies(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskDependency, error) {
+	var collected []*domain.TaskDependency
+	visited := map[int64]bool{taskID: true}
+	frontier := []int64{taskID}
+
+	for len(frontier) > 0 {
+		dependencies, err := u.dependencyRepo.FindByTaskIDs(ctx, ex, frontier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find dependencies: %w", err)
+		}
+
+		frontier = nil
+		for _, dep := range dependencies {
+			collected = append(collected, dep)
+			if !visited[dep.DependsOnTaskID] {
+				visited[dep.DependsOnTaskID] = true
+				frontier = append(frontier, dep.DependsOnTaskID)
+			}
+		}
+	}
+
+	return collected, nil
+}
@@ -1,30 +1,57 @@
 package task
 
-import "time"
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
 
 // ListTasksRequest はタスク一覧取得のリクエスト
+// Filter.NowとFilter.Locationはユースケースで閲覧者の現在時刻とタイムゾーンを設定する
+// Afterは前のページのレスポンスのNextCursor（最初のページはnil）
 type ListTasksRequest struct {
-	Limit  int
-	Offset int
+	Filter       domain.TaskFilter
+	After        *domain.TaskCursor
+	Limit        int
+	IncludeTotal bool
+}
+
+// ListTasksResponse はタスク一覧取得のレスポンス
+// NextCursorは次のページがない場合nil、TotalはIncludeTotalを指定した場合のみ設定する
+type ListTasksResponse struct {
+	Tasks      []*TaskResponse
+	NextCursor *domain.TaskCursor
+	Total      *int
 }
 
 // CreateTaskRequest はタスク作成のリクエスト
 type CreateTaskRequest struct {
-	Title       string
-	Description *string
-	DueDate     *time.Time
-	Priority    int
-	AssigneeIDs []int64
+	Title            string
+	Description      *string
+	StartDate        *time.Time
+	DueDate          *time.Time
+	DueAllDay        bool
+	Priority         int
+	AssigneeIDs      []int64
+	AssigneeGroupIDs []int64
 }
 
 // UpdateTaskRequest はタスク更新のリクエスト
 type UpdateTaskRequest struct {
-	Title       *string
-	Description *string
-	DueDate     *time.Time
-	Status      *string
-	Priority    *int
-	AssigneeIDs []int64
+	Title            *string
+	Description      *string
+	StartDate        *time.Time
+	DueDate          *time.Time
+	DueAllDay        bool
+	Status           *string
+	Priority         *int
+	AssigneeIDs      []int64
+	AssigneeGroupIDs []int64
+	// ClearStartDate・ClearDueDateがtrueの場合は開始日・期日をなしにする（StartDate・DueDateがnilの場合は変更しない）
+	ClearStartDate bool
+	ClearDueDate   bool
+	// ExpectedVersionを指定した場合、タスクのバージョンが一致するときだけ更新する
+	ExpectedVersion *int64
 }
 
 // TaskResponse はタスクのレスポンス
@@ -33,12 +60,34 @@ type TaskResponse struct {
 	OwnerID     int64
 	Title       string
 	Description *string
-	DueDate     *time.Time
-	Status      string
-	Priority    int
-	Assignees   []AssigneeResponse
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// DescriptionHTMLは説明をMarkdownとして変換したサニタイズ済みのHTML（説明がない場合はnil）
+	DescriptionHTML *string
+	StartDate       *time.Time
+	DueDate         *time.Time
+	DueAllDay       bool
+	Overdue         bool
+	Status          string
+	Priority        int
+	StartedAt       *time.Time
+	CompletedAt     *time.Time
+	SLA             *SLAResponse
+	Assignees       []AssigneeResponse
+	GroupAssignees  []GroupAssigneeResponse
+	Version         int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// SLAResponse はタスクのSLA達成状況のレスポンス
+type SLAResponse struct {
+	StartDeadline   time.Time
+	ResolveDeadline time.Time
+	TimeToStart     *time.Duration
+	TimeToDone      *time.Duration
+	StartBreached   bool
+	ResolveBreached bool
+	Breached        bool
+	EscalatedAt     *time.Time
 }
 
 // AssigneeResponse はアサイン情報のレスポンス
@@ -47,3 +96,302 @@ type AssigneeResponse struct {
 	AssignedBy int64
 	AssignedAt time.Time
 }
+
+// GroupAssigneeResponse はグループアサイン情報のレスポンス
+type GroupAssigneeResponse struct {
+	GroupID    int64
+	AssignedBy int64
+	AssignedAt time.Time
+}
+
+// ShareTaskRequest はタスク共有のリクエスト
+type ShareTaskRequest struct {
+	UserID     int64
+	Permission string
+}
+
+// ShareResponse は共有設定のレスポンス
+type ShareResponse struct {
+	UserID     int64
+	Permission string
+	SharedBy   int64
+	SharedAt   time.Time
+}
+
+// StatsResponse はタスクの集計のレスポンス
+// ByStatus・ByPriorityは該当するタスクがないステータス・優先度も0として含む
+type StatsResponse struct {
+	Total       int
+	ByStatus    map[string]int
+	ByPriority  map[int]int
+	Overdue     int
+	DueThisWeek int
+	Owned       int
+	Assigned    int
+}
+
+// AnalyticsRequest は分析のリクエスト
+// FromとToは年月日のみを使い、ユーザーのタイムゾーンでの日付として扱う（両端を含む）
+type AnalyticsRequest struct {
+	From time.Time
+	To   time.Time
+}
+
+// DurationStatsResponse はリードタイム・サイクルタイムの統計のレスポンス
+type DurationStatsResponse struct {
+	From    time.Time
+	To      time.Time
+	Count   int
+	Average time.Duration
+	Median  time.Duration
+	P85     time.Duration
+}
+
+// ThroughputResponse は週ごとの完了数のレスポンス
+type ThroughputResponse struct {
+	From  time.Time
+	To    time.Time
+	Weeks []WeeklyThroughputResponse
+}
+
+// WeeklyThroughputResponse は1週間（月曜日から）の完了数
+type WeeklyThroughputResponse struct {
+	WeekStart time.Time
+	Completed int
+}
+
+// StatusSnapshotsResponse は日ごとのステータス別タスク数のレスポンス
+type StatusSnapshotsResponse struct {
+	From time.Time
+	To   time.Time
+	Days []StatusSnapshotResponse
+}
+
+// StatusSnapshotResponse はある日の終わり時点のステータス別タスク数
+type StatusSnapshotResponse struct {
+	Date   time.Time
+	Counts map[string]int
+}
+
+// WorkloadRequest は担当者ごとの負荷レポートのリクエスト
+// Filterはステータスと期日の範囲のみ使い、ステータスの指定がない場合は未完了（TODO・IN_PROGRESS）のタスクを集計する
+type WorkloadRequest struct {
+	Filter domain.TaskFilter
+}
+
+// WorkloadResponse は担当者ごとの負荷レポートのレスポンス
+type WorkloadResponse struct {
+	Users []WorkloadUserResponse
+}
+
+// WorkloadUserResponse は1人の担当者の負荷
+type WorkloadUserResponse struct {
+	UserID     int64
+	Name       string
+	Email      string
+	Total      int
+	ByPriority map[int]int
+	Overdue    int
+}
+
+// QuickAddRequest はクイック追加のリクエスト
+// DryRunの場合は解析結果のみ返し、タスクを作成しない
+type QuickAddRequest struct {
+	Input  string
+	DryRun bool
+}
+
+// QuickAddResponse はクイック追加のレスポンス（DryRunの場合Taskはnil）
+type QuickAddResponse struct {
+	Parsed QuickAddParsedResponse
+	Task   *TaskResponse
+}
+
+// QuickAddParsedResponse は入力の解析結果
+// DueDateは時刻付きの場合ユーザーのタイムゾーン、終日の場合は日付（UTCの0時）
+type QuickAddParsedResponse struct {
+	Title     string
+	DueDate   *time.Time
+	DueAllDay bool
+	Priority  *int
+	Assignees []MentionResponse
+	Labels    []string
+}
+
+// MentionResponse はメンションと対応するユーザー（見つからない・複数に一致する場合UserIDはnil）
+type MentionResponse struct {
+	Mention string
+	UserID  *int64
+}
+
+// TimelineRequest はタイムライン取得のリクエスト
+type TimelineRequest struct {
+	From time.Time
+	To   time.Time
+}
+
+// TimelineResponse はタイムライン（ガントチャート）のレスポンス
+type TimelineResponse struct {
+	From  time.Time
+	To    time.Time
+	Tasks []TimelineTaskResponse
+}
+
+// TimelineTaskResponse はタイムライン上のタスク
+type TimelineTaskResponse struct {
+	ID        int64
+	Title     string
+	Status    string
+	Priority  int
+	StartDate *time.Time
+	DueDate   *time.Time
+	DueAllDay bool
+	Overdue   bool
+	DependsOn []int64
+}
+
+// AddDependencyRequest は依存関係追加のリクエスト
+type AddDependencyRequest struct {
+	DependsOnTaskID int64
+}
+
+// DependencyResponse は依存関係のレスポンス
+type DependencyResponse struct {
+	TaskID          int64
+	DependsOnTaskID int64
+	CreatedBy       int64
+	CreatedAt       time.Time
+}
+
+// BulkMode は一括操作の適用モード
+type BulkMode string
+
+const (
+	// BulkModeAtomic は1件でも失敗した場合にすべての変更を適用しない
+	BulkModeAtomic BulkMode = "atomic"
+	// BulkModeBestEffort は成功したタスクのみ変更を適用する
+	BulkModeBestEffort BulkMode = "best_effort"
+)
+
+// BulkTaskRequest はタスク一括操作のリクエスト
+type BulkTaskRequest struct {
+	TaskIDs           []int64
+	Mode              BulkMode
+	Status            *string
+	Priority          *int
+	DueDate           *time.Time
+	DueAllDay         bool
+	AddAssigneeIDs    []int64
+	RemoveAssigneeIDs []int64
+	Delete            bool
+}
+
+// BulkTaskResponse はタスク一括操作のレスポンス
+type BulkTaskResponse struct {
+	Mode      BulkMode
+	Applied   bool
+	Succeeded int
+	Failed    int
+	Results   []BulkTaskResult
+}
+
+// BulkTaskResult はタスクごとの一括操作の結果
+type BulkTaskResult struct {
+	TaskID  int64
+	Success bool
+	Err     error
+}
+
+// ImportTasksRequest はタスク一括インポートのリクエスト
+type ImportTasksRequest struct {
+	Rows   []ImportTaskRow
+	DryRun bool
+}
+
+// ImportTaskRow はインポートする1行分の値（CSV/JSONの値を文字列のまま保持する）
+type ImportTaskRow struct {
+	Line          int
+	Title         string
+	Description   string
+	DueDate       string
+	Priority      string
+	Status        string
+	AssigneeEmail string
+}
+
+// ImportTasksResponse はタスク一括インポートの結果
+type ImportTasksResponse struct {
+	DryRun   bool
+	Total    int
+	Valid    int
+	Imported int
+	TaskIDs  []int64
+	Errors   []ImportRowError
+}
+
+// ImportRowError はインポート時の行ごとのエラー
+type ImportRowError struct {
+	Line    int
+	Field   string
+	Message string
+}
+
+// ExportTaskRow はエクスポートするタスク1件分の値
+type ExportTaskRow struct {
+	ID            int64
+	Title         string
+	Description   *string
+	Status        string
+	Priority      int
+	StartDate     *time.Time
+	DueDate       *time.Time
+	DueAllDay     bool
+	AssigneeNames []string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// SearchTasksRequest はタスク検索のリクエスト
+type SearchTasksRequest struct {
+	Query string
+	Limit int
+}
+
+// SearchResult はタスク検索の1件の結果
+// ハイライトはHTMLエスケープ済みで、一致箇所を<mark>で囲む
+type SearchResult struct {
+	Task                 *TaskResponse
+	Score                float64
+	TitleHighlight       string
+	DescriptionHighlight *string
+}
+
+// RevisionResponse はタスクのリビジョンのレスポンス
+type RevisionResponse struct {
+	Revision         int64
+	Title            string
+	Description      *string
+	StartDate        *time.Time
+	DueDate          *time.Time
+	DueAllDay        bool
+	Status           string
+	Priority         int
+	AssigneeIDs      []int64
+	AssigneeGroupIDs []int64
+	ChangedBy        *int64
+	CreatedAt        time.Time
+}
+
+// RevisionDiffResponse はリビジョン間の差分のレスポンス
+type RevisionDiffResponse struct {
+	From    int64
+	To      int64
+	Changes []FieldChangeResponse
+}
+
+// FieldChangeResponse は項目ごとの変更前後の値（日時は閲覧者のタイムゾーンに変換済み）
+type FieldChangeResponse struct {
+	Field string
+	From  any
+	To    any
+}
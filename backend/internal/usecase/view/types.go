@@ -0,0 +1,45 @@
+package view
+
+import (
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// CreateViewRequest は保存済みビュー作成のリクエスト
+type CreateViewRequest struct {
+	Name   string
+	Filter domain.TaskFilter
+}
+
+// UpdateViewRequest は保存済みビュー更新のリクエスト（nilの項目は変更しない）
+type UpdateViewRequest struct {
+	Name   *string
+	Filter *domain.TaskFilter
+}
+
+// ListViewTasksRequest はビューの条件でのタスク一覧取得のリクエスト
+type ListViewTasksRequest struct {
+	After        *domain.TaskCursor
+	Limit        int
+	IncludeTotal bool
+}
+
+// ViewResponse は保存済みビューのレスポンス
+// SharedWithはオーナーが取得した場合のみ設定する
+type ViewResponse struct {
+	ID         int64
+	OwnerID    int64
+	Name       string
+	Filter     domain.TaskFilter
+	SharedWith []ViewShareResponse
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ViewShareResponse はビューの共有先のレスポンス
+type ViewShareResponse struct {
+	UserID   int64
+	SharedBy int64
+	SharedAt time.Time
+}
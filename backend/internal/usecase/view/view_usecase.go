@@ -0,0 +1,269 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+// TaskListerはタスク一覧を取得する（task.TaskUseCaseが実装する）
+type TaskLister interface {
+	ListTasks(ctx context.Context, userID int64, req taskuc.ListTasksRequest) (*taskuc.ListTasksResponse, error)
+}
+
+// ViewUseCaseは保存済みビューのユースケースを提供する
+type ViewUseCase struct {
+	viewRepo   domain.SavedViewRepository
+	shareRepo  domain.SavedViewShareRepository
+	userRepo   domain.UserRepository
+	taskLister TaskLister
+	txManager  domain.TxManager
+	clock      domain.Clock
+}
+
+// NewViewUseCaseで新しいViewUseCaseを作成
+func NewViewUseCase(
+	viewRepo domain.SavedViewRepository,
+	shareRepo domain.SavedViewShareRepository,
+	userRepo domain.UserRepository,
+	taskLister TaskLister,
+	txManager domain.TxManager,
+	clock domain.Clock,
+) *ViewUseCase {
+	return &ViewUseCase{
+		viewRepo:   viewRepo,
+		shareRepo:  shareRepo,
+		userRepo:   userRepo,
+		taskLister: taskLister,
+		txManager:  txManager,
+		clock:      clock,
+	}
+}
+
+// ListViewsは自分のビューと共有されたビューの一覧を取得
+func (u *ViewUseCase) ListViews(ctx context.Context, userID int64) ([]*ViewResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	views, err := u.viewRepo.ListAccessibleByUserID(ctx, executor, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+
+	responses := make([]*ViewResponse, len(views))
+	for i, view := range views {
+		responses[i], err = u.loadViewResponse(ctx, executor, view, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return responses, nil
+}
+
+// CreateViewはビューを作成
+func (u *ViewUseCase) CreateView(ctx context.Context, userID int64, req CreateViewRequest) (*ViewResponse, error) {
+	view, err := domain.NewSavedView(u.clock, userID, req.Name, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.viewRepo.Create(ctx, u.txManager.AsExecutor(), view); err != nil {
+		return nil, err
+	}
+
+	return toViewResponse(view, []*domain.SavedViewShare{}, userID), nil
+}
+
+// GetViewはビューを取得（オーナーまたは共有先のみ）
+func (u *ViewUseCase) GetView(ctx context.Context, userID, viewID int64) (*ViewResponse, error) {
+	executor := u.txManager.AsExecutor()
+
+	view, shares, err := u.findUsableView(ctx, executor, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toViewResponse(view, shares, userID), nil
+}
+
+// UpdateViewはビューの名前・絞り込み条件を更新（オーナーのみ）
+func (u *ViewUseCase) UpdateView(ctx context.Context, userID, viewID int64, req UpdateViewRequest) (*ViewResponse, error) {
+	var response *ViewResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		view, err := u.findOwnedView(ctx, ex, userID, viewID)
+		if err != nil {
+			return err
+		}
+
+		if req.Name != nil {
+			if err := view.Rename(u.clock, *req.Name); err != nil {
+				return err
+			}
+		}
+		if req.Filter != nil {
+			if err := view.UpdateFilter(u.clock, *req.Filter); err != nil {
+				return err
+			}
+		}
+
+		if err := u.viewRepo.Update(ctx, ex, view); err != nil {
+			return err
+		}
+
+		response, err = u.loadViewResponse(ctx, ex, view, userID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// DeleteViewはビューを削除（オーナーのみ）
+func (u *ViewUseCase) DeleteView(ctx context.Context, userID, viewID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		if _, err := u.findOwnedView(ctx, ex, userID, viewID); err != nil {
+			return err
+		}
+		return u.viewRepo.Delete(ctx, ex, viewID)
+	})
+}
+
+// ShareViewはビューをユーザーと共有（オーナーのみ）
+// 共有されるのは絞り込み条件のみで、共有先が実行した場合も共有先が閲覧可能なタスクしか表示されない
+func (u *ViewUseCase) ShareView(ctx context.Context, userID, viewID, targetUserID int64) (*ViewShareResponse, error) {
+	var response *ViewShareResponse
+
+	err := u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		view, err := u.findOwnedView(ctx, ex, userID, viewID)
+		if err != nil {
+			return err
+		}
+
+		// 共有先ユーザーが存在するか確認
+		if _, err := u.userRepo.FindByID(ctx, ex, targetUserID); err != nil {
+			return fmt.Errorf("share user not found: %w", err)
+		}
+
+		share, err := domain.NewSavedViewShare(u.clock, view, targetUserID, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.shareRepo.Upsert(ctx, ex, share); err != nil {
+			return fmt.Errorf("failed to share view: %w", err)
+		}
+
+		response = &toViewShareResponses([]*domain.SavedViewShare{share})[0]
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RevokeViewShareはビューの共有を解除（オーナーのみ）
+func (u *ViewUseCase) RevokeViewShare(ctx context.Context, userID, viewID, targetUserID int64) error {
+	return u.txManager.Do(ctx, func(ctx context.Context, ex domain.Executor) error {
+		if _, err := u.findOwnedView(ctx, ex, userID, viewID); err != nil {
+			return err
+		}
+		return u.shareRepo.Delete(ctx, ex, viewID, targetUserID)
+	})
+}
+
+// ListViewTasksはビューの絞り込み条件と並び順でタスク一覧を取得
+// 閲覧範囲は常に実行したユーザーのもの（ビューのオーナーのものではない）
+func (u *ViewUseCase) ListViewTasks(ctx context.Context, userID, viewID int64, req ListViewTasksRequest) (*taskuc.ListTasksResponse, error) {
+	view, _, err := u.findUsableView(ctx, u.txManager.AsExecutor(), userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.taskLister.ListTasks(ctx, userID, taskuc.ListTasksRequest{
+		Filter:       view.Filter,
+		After:        req.After,
+		Limit:        req.Limit,
+		IncludeTotal: req.IncludeTotal,
+	})
+}
+
+// findUsableViewはオーナーまたは共有先のユーザーのみビューと共有一覧を取得できる
+func (u *ViewUseCase) findUsableView(ctx context.Context, ex domain.Executor, userID, viewID int64) (*domain.SavedView, []*domain.SavedViewShare, error) {
+	view, err := u.viewRepo.FindByID(ctx, ex, viewID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shares, err := u.shareRepo.FindByViewID(ctx, ex, viewID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find view shares: %w", err)
+	}
+
+	if !domain.CanUseSavedView(view, shares, userID) {
+		return nil, nil, domain.ErrForbidden
+	}
+
+	return view, shares, nil
+}
+
+// findOwnedViewはオーナーのみビューを取得できる
+func (u *ViewUseCase) findOwnedView(ctx context.Context, ex domain.Executor, userID, viewID int64) (*domain.SavedView, error) {
+	view, err := u.viewRepo.FindByID(ctx, ex, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if !view.IsOwner(userID) {
+		return nil, domain.ErrForbidden
+	}
+	return view, nil
+}
+
+// loadViewResponseはビューの共有一覧を取得してViewResponseを作成
+func (u *ViewUseCase) loadViewResponse(ctx context.Context, ex domain.Executor, view *domain.SavedView, userID int64) (*ViewResponse, error) {
+	if !view.IsOwner(userID) {
+		return toViewResponse(view, nil, userID), nil
+	}
+	shares, err := u.shareRepo.FindByViewID(ctx, ex, view.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find view shares: %w", err)
+	}
+	return toViewResponse(view, shares, userID), nil
+}
+
+// toViewResponseはdomain.SavedViewからViewResponseを作成（共有先はオーナーにのみ返す）
+func toViewResponse(view *domain.SavedView, shares []*domain.SavedViewShare, userID int64) *ViewResponse {
+	response := &ViewResponse{
+		ID:        view.ID,
+		OwnerID:   view.OwnerID,
+		Name:      view.Name,
+		Filter:    view.Filter,
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}
+	if view.IsOwner(userID) {
+		response.SharedWith = toViewShareResponses(shares)
+	}
+	return response
+}
+
+// toViewShareResponsesはdomain.SavedViewShareのスライスをViewShareResponseのスライスに変換
+func toViewShareResponses(shares []*domain.SavedViewShare) []ViewShareResponse {
+	responses := make([]ViewShareResponse, len(shares))
+	for i, share := range shares {
+		responses[i] = ViewShareResponse{
+			UserID:   share.UserID,
+			SharedBy: share.SharedBy,
+			SharedAt: share.CreatedAt,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,14 @@
+-- task_shares table
+CREATE TABLE task_shares (
+    task_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    permission ENUM('VIEW', 'COMMENT') NOT NULL DEFAULT 'VIEW',
+    shared_by BIGINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, user_id),
+    INDEX idx_user (user_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (shared_by) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN due_all_day;
+ALTER TABLE users DROP COLUMN timezone;
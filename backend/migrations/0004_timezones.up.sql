@@ -0,0 +1,7 @@
+-- ユーザーごとの表示タイムゾーン（IANA名）
+ALTER TABLE users
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' AFTER name;
+
+-- 終日の期日フラグ（due_dateはUTCで保存し、終日の場合はUTCの0時に日付を保持する）
+ALTER TABLE tasks
+    ADD COLUMN due_all_day TINYINT(1) NOT NULL DEFAULT 0 AFTER due_date;
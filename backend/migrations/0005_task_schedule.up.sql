@@ -0,0 +1,17 @@
+-- 開始日（UTCで保存する）
+ALTER TABLE tasks
+    ADD COLUMN start_date DATETIME NULL AFTER description,
+    ADD INDEX idx_start (start_date);
+
+-- task_dependencies table（task_idのタスクはdepends_on_task_idのタスクの完了を待つ）
+CREATE TABLE task_dependencies (
+    task_id BIGINT NOT NULL,
+    depends_on_task_id BIGINT NOT NULL,
+    created_by BIGINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, depends_on_task_id),
+    INDEX idx_depends_on (depends_on_task_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (depends_on_task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
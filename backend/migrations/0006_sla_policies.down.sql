@@ -0,0 +1,5 @@
+ALTER TABLE tasks
+    DROP COLUMN sla_escalated_at,
+    DROP COLUMN completed_at,
+    DROP COLUMN started_at;
+DROP TABLE IF EXISTS sla_policies;
@@ -0,0 +1,26 @@
+-- sla_policies table（優先度ごとの着手・完了までの期限）
+CREATE TABLE sla_policies (
+    priority TINYINT NOT NULL PRIMARY KEY,
+    start_within_minutes INT NOT NULL,
+    resolve_within_minutes INT NOT NULL,
+    escalate_before_minutes INT NOT NULL DEFAULT 60,
+    escalation_action ENUM('RAISE_PRIORITY', 'NOTIFY_OWNER', 'BOTH') NOT NULL DEFAULT 'NOTIFY_OWNER',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 優先度3以上のタスクにSLAを設定（優先度0〜2はSLA対象外）
+INSERT INTO sla_policies (priority, start_within_minutes, resolve_within_minutes, escalate_before_minutes, escalation_action) VALUES
+    (5, 60, 1440, 15, 'BOTH'),
+    (4, 240, 4320, 60, 'BOTH'),
+    (3, 480, 7200, 120, 'NOTIFY_OWNER');
+
+-- SLA計測用の着手・完了日時とエスカレーション日時
+ALTER TABLE tasks
+    ADD COLUMN started_at DATETIME NULL AFTER priority,
+    ADD COLUMN completed_at DATETIME NULL AFTER started_at,
+    ADD COLUMN sla_escalated_at DATETIME NULL AFTER completed_at;
+
+-- 既存タスクは最終更新日時を着手・完了日時とみなす
+UPDATE tasks SET started_at = updated_at WHERE status IN ('IN_PROGRESS', 'DONE');
+UPDATE tasks SET completed_at = updated_at WHERE status = 'DONE';
@@ -0,0 +1,8 @@
+-- calendar_tokens table（icsフィード用のユーザーごとの秘密トークン。ハッシュのみ保存する）
+CREATE TABLE calendar_tokens (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_token_hash (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
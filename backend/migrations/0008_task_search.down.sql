@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP INDEX ft_title_description;
+ALTER TABLE tasks DROP INDEX ft_title;
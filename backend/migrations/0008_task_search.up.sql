@@ -0,0 +1,5 @@
+-- タスクの全文検索用インデックス（日本語を扱うためngramパーサーを使用）
+-- InnoDBはFULLTEXTインデックスを1つずつしか作成できないため、文を分ける
+-- タイトルのみのインデックスはタイトルでの一致のスコアを重み付けするために使う
+ALTER TABLE tasks ADD FULLTEXT INDEX ft_title (title) WITH PARSER ngram;
+ALTER TABLE tasks ADD FULLTEXT INDEX ft_title_description (title, description) WITH PARSER ngram;
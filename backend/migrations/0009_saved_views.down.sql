@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS saved_view_shares;
+DROP TABLE IF EXISTS saved_views;
@@ -0,0 +1,2 @@
+-- 楽観的排他制御用のバージョン（ETag/If-Matchで使う。更新・削除のたびに1ずつ増やす）
+ALTER TABLE tasks ADD COLUMN version BIGINT NOT NULL DEFAULT 1 AFTER sla_escalated_at;
@@ -0,0 +1,15 @@
+-- idempotency_keys table（Idempotency-Keyヘッダーごとのリクエストの処理結果）
+-- user_idは認証不要のエンドポイント（サインアップなど）では0のため外部キーを張らない
+-- status_codeがNULLの行は処理中で、処理が終わるとレスポンスを保存してリトライ時に再送する
+CREATE TABLE idempotency_keys (
+    user_id BIGINT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint CHAR(64) NOT NULL,
+    status_code INT NULL,
+    response_headers JSON NULL,
+    response_body LONGBLOB NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id, idempotency_key),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
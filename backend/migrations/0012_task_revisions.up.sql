@@ -0,0 +1,31 @@
+-- task_revisions table（タスクの作成・更新ごとの内容のスナップショット。revisionはtasks.versionと同じ）
+-- アサインはその時点のユーザーID・グループIDの配列（昇順）をJSONで保存する
+CREATE TABLE task_revisions (
+    task_id BIGINT NOT NULL,
+    revision BIGINT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    start_date DATETIME NULL,
+    due_date DATETIME NULL,
+    due_all_day TINYINT(1) NOT NULL DEFAULT 0,
+    status ENUM('TODO', 'IN_PROGRESS', 'DONE') NOT NULL,
+    priority TINYINT NOT NULL,
+    assignee_ids JSON NOT NULL,
+    assignee_group_ids JSON NOT NULL,
+    changed_by BIGINT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, revision),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 既存のタスクは現在の内容を最初のリビジョンとして記録する
+INSERT INTO task_revisions (task_id, revision, title, description, start_date, due_date, due_all_day, status, priority,
+    assignee_ids, assignee_group_ids, changed_by, created_at)
+SELECT
+    t.id, t.version, t.title, t.description, t.start_date, t.due_date, t.due_all_day, t.status, t.priority,
+    COALESCE((SELECT JSON_ARRAYAGG(a.user_id) FROM (SELECT user_id FROM task_assignees WHERE task_id = t.id ORDER BY user_id) a), JSON_ARRAY()),
+    COALESCE((SELECT JSON_ARRAYAGG(g.group_id) FROM (SELECT group_id FROM task_group_assignees WHERE task_id = t.id ORDER BY group_id) g), JSON_ARRAY()),
+    NULL, t.updated_at
+FROM tasks t
+WHERE t.deleted_at IS NULL;
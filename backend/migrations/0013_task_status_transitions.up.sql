@@ -0,0 +1,43 @@
+-- task_status_transitions table（タスクのステータス遷移の記録。作成時はfrom_statusをNULLとして記録する）
+CREATE TABLE task_status_transitions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    task_id BIGINT NOT NULL,
+    from_status ENUM('TODO', 'IN_PROGRESS', 'DONE') NULL,
+    to_status ENUM('TODO', 'IN_PROGRESS', 'DONE') NOT NULL,
+    changed_by BIGINT NULL,
+    transitioned_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_task_status_transitions_task (task_id, transitioned_at),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 既存のタスクは作成・着手・完了日時から遷移を推定して記録する
+-- 作成
+INSERT INTO task_status_transitions (task_id, from_status, to_status, changed_by, transitioned_at)
+SELECT id, NULL, 'TODO', NULL, created_at
+FROM tasks
+WHERE deleted_at IS NULL;
+
+-- 着手（着手せずに完了した場合は着手日時と完了日時が同じ）
+INSERT INTO task_status_transitions (task_id, from_status, to_status, changed_by, transitioned_at)
+SELECT id, 'TODO', 'IN_PROGRESS', NULL, started_at
+FROM tasks
+WHERE deleted_at IS NULL
+  AND started_at IS NOT NULL
+  AND (completed_at IS NULL OR started_at < completed_at);
+
+-- 完了
+INSERT INTO task_status_transitions (task_id, from_status, to_status, changed_by, transitioned_at)
+SELECT id, IF(started_at < completed_at, 'IN_PROGRESS', 'TODO'), 'DONE', NULL, completed_at
+FROM tasks
+WHERE deleted_at IS NULL
+  AND status = 'DONE'
+  AND completed_at IS NOT NULL;
+
+-- 着手後にTODOへ戻したタスク（戻した日時は不明なため最終更新日時とする）
+INSERT INTO task_status_transitions (task_id, from_status, to_status, changed_by, transitioned_at)
+SELECT id, 'IN_PROGRESS', 'TODO', NULL, updated_at
+FROM tasks
+WHERE deleted_at IS NULL
+  AND status = 'TODO'
+  AND started_at IS NOT NULL;
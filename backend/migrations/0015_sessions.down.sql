@@ -0,0 +1,10 @@
+DELETE FROM refresh_tokens;
+ALTER TABLE refresh_tokens
+    DROP FOREIGN KEY fk_refresh_tokens_session,
+    DROP INDEX idx_session_id,
+    DROP COLUMN session_id,
+    ADD COLUMN family_id CHAR(32) NOT NULL AFTER user_id,
+    ADD COLUMN revoked_at DATETIME NULL AFTER rotated_at,
+    ADD INDEX idx_family_id (family_id);
+
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,26 @@
+-- sessions table（ログインごとのセッション。アクセストークンはsidクレームでセッションを参照する）
+-- expires_atはリフレッシュトークンの有効期限で、再発行のたびに延長する
+CREATE TABLE sessions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME NULL,
+    INDEX idx_user_id (user_id),
+    INDEX idx_expires_at (expires_at),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- リフレッシュトークンのファミリーをセッションに置き換える（無効化はセッション単位で行う）
+-- 既存のリフレッシュトークンはセッションに属さないため削除する（再ログインが必要）
+DELETE FROM refresh_tokens;
+ALTER TABLE refresh_tokens
+    DROP INDEX idx_family_id,
+    DROP COLUMN family_id,
+    DROP COLUMN revoked_at,
+    ADD COLUMN session_id BIGINT NOT NULL AFTER user_id,
+    ADD INDEX idx_session_id (session_id),
+    ADD CONSTRAINT fk_refresh_tokens_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE;
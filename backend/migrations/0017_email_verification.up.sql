@@ -0,0 +1,6 @@
+-- メールアドレスの確認日時（未確認の場合はNULL）
+ALTER TABLE users
+    ADD COLUMN email_verified_at DATETIME NULL AFTER token_version;
+
+-- 既存のユーザーは確認済みとして扱う（導入時にログイン・アサインできなくならないように）
+UPDATE users SET email_verified_at = created_at;
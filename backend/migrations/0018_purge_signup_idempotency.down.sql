@@ -0,0 +1,2 @@
+-- 削除したレスポンスは復元できない（保存期間が過ぎれば不要なデータのため何もしない）
+SELECT 1;
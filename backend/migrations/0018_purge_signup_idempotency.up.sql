@@ -0,0 +1,3 @@
+-- ユーザー登録のレスポンス（トークンを含む）を冪等キーの記録として保存していたため、保存済みのものを削除する
+-- 認証不要のエンドポイントのキーはuser_idが0
+DELETE FROM idempotency_keys WHERE user_id = 0;
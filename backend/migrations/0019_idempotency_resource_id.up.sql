@@ -0,0 +1,4 @@
+-- レスポンスを保存しないエンドポイント（トークンを返すユーザー登録）で、作成したリソースのIDを保存する
+-- リトライ時は保存したレスポンスを再送せず、このIDからレスポンスを作り直す
+ALTER TABLE idempotency_keys
+    ADD COLUMN resource_id BIGINT NULL AFTER response_body;
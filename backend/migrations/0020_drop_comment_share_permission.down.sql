@@ -0,0 +1,2 @@
+-- VIEWに変更した共有はCOMMENTに戻せないため、列の定義のみ戻す
+ALTER TABLE task_shares MODIFY permission ENUM('VIEW', 'COMMENT') NOT NULL DEFAULT 'VIEW';
@@ -0,0 +1,3 @@
+-- コメント機能がなくCOMMENT権限では何も許可していなかったため廃止し、既存の共有は閲覧のみ（VIEW）にする
+UPDATE task_shares SET permission = 'VIEW' WHERE permission = 'COMMENT';
+ALTER TABLE task_shares MODIFY permission ENUM('VIEW') NOT NULL DEFAULT 'VIEW';
@@ -14,12 +14,14 @@ type Clock func() time.Time
 type JWTClaims struct {
 	UID         int64 `json:"uid"`   
 	TokenVersion int   `json:"tkn_ver"` 
+	// SessionIDはトークンを発行したログインセッションのID
+	SessionID int64 `json:"sid"`
 	jwt.RegisteredClaims
 }
 
 // JWTServiceはJWTの生成と解析を行うインターフェース
 type JWTService interface {
-	GenerateToken(userID int64, tokenVersion int) (string, error)
+	GenerateToken(userID int64, tokenVersion int, sessionID int64) (string, error)
 	ParseToken(tokenString string) (*JWTClaims, error)
 }
 
@@ -45,11 +47,12 @@ func NewJWTService(secret, issuer string, ttl time.Duration, clock Clock) JWTSer
 }
 
 // GenerateTokenはJWTを生成する
-func (s *jwtService) GenerateToken(userID int64, tokenVersion int) (string, error) {
+func (s *jwtService) GenerateToken(userID int64, tokenVersion int, sessionID int64) (string, error) {
 	now := s.clock()
 	claims := &JWTClaims{
 		UID:          userID,
 		TokenVersion: tokenVersion,
+		SessionID:    sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    s.issuer,
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -0,0 +1,23 @@
+package csvutil
+
+import "strings"
+
+// formulaPrefixesは表計算ソフトがセルを数式として解釈する先頭文字
+const formulaPrefixes = "=+-@\t\r"
+
+// EscapeFormulaは数式として解釈される文字で始まるセルの先頭に「'」を付け、
+// CSVを表計算ソフトで開いたときに数式が実行されないようにする（CSVインジェクション対策）
+func EscapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune(formulaPrefixes, rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// UnescapeFormulaはEscapeFormulaで付けた「'」を取り除く（書き出したCSVを取り込み直したときに元の値に戻す）
+func UnescapeFormula(cell string) string {
+	if len(cell) >= 2 && cell[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(cell[1])) {
+		return cell[1:]
+	}
+	return cell
+}
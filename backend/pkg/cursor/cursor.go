@@ -0,0 +1,42 @@
+package cursor
+
+import (
+	"errors"
+
+	"github.com/ryusuke/task_app_layerx/pkg/signedtoken"
+)
+
+// ErrInvalidCursorはカーソルの形式が不正、または署名が一致しない場合のエラー
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// purposeはカーソルの署名に含める用途（他の用途の署名付きトークンをカーソルとして受け付けない）
+const purpose = "cursor"
+
+// Signerはページングのカーソルを改ざんできない不透明な文字列に変換するインターフェース
+type Signer interface {
+	Encode(payload any) (string, error)
+	Decode(token string, payload any) error
+}
+
+// signerはsignedtokenでカーソルに署名するSignerの実装
+type signer struct {
+	signer signedtoken.Signer
+}
+
+// NewSignerで新しいSignerを作成する
+func NewSigner(secret string) Signer {
+	return &signer{signer: signedtoken.NewSigner(secret, purpose)}
+}
+
+// EncodeはpayloadをJSONにして署名し、「本文.署名」をbase64urlで表した文字列を返す
+func (s *signer) Encode(payload any) (string, error) {
+	return s.signer.Encode(payload)
+}
+
+// Decodeは署名を検証してpayloadに読み込む
+func (s *signer) Decode(token string, payload any) error {
+	if err := s.signer.Decode(token, payload); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ComponentはiCalendarのコンポーネント種別
+type Component string
+
+const (
+	ComponentTodo  Component = "VTODO"
+	ComponentEvent Component = "VEVENT"
+)
+
+// TodoStatusはVTODOのSTATUS値
+type TodoStatus string
+
+const (
+	TodoStatusNeedsAction TodoStatus = "NEEDS-ACTION"
+	TodoStatusInProcess   TodoStatus = "IN-PROCESS"
+	TodoStatusCompleted   TodoStatus = "COMPLETED"
+)
+
+// Itemはカレンダーに載せる1件（VTODOまたはVEVENTとして出力する）
+type Item struct {
+	UID          string
+	Summary      string
+	Description  string
+	URL          string
+	Due          time.Time
+	AllDay       bool
+	Status       TodoStatus
+	Priority     int
+	Categories   []string
+	Completed    *time.Time
+	LastModified time.Time
+}
+
+// Calendarはicsファイル全体
+type Calendar struct {
+	ProdID    string
+	Name      string
+	Component Component
+	Items     []Item
+	Now       time.Time
+}
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405Z"
+	// maxLineOctetsはRFC 5545で推奨される1行の最大オクテット数（CRLFを除く）
+	maxLineOctets = 75
+)
+
+// Encodeはカレンダーをics形式（RFC 5545）で書き出す
+func (c *Calendar) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	lw := &lineWriter{w: bw}
+
+	component := c.Component
+	if component == "" {
+		component = ComponentTodo
+	}
+
+	lw.line("BEGIN", "VCALENDAR")
+	lw.line("VERSION", "2.0")
+	lw.line("PRODID", c.ProdID)
+	lw.line("CALSCALE", "GREGORIAN")
+	lw.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, item := range c.Items {
+		lw.line("BEGIN", string(component))
+		lw.line("UID", item.UID)
+		lw.line("DTSTAMP", c.Now.UTC().Format(dateTimeLayout))
+		lw.line("SUMMARY", escapeText(item.Summary))
+		if item.Description != "" {
+			lw.line("DESCRIPTION", escapeText(item.Description))
+		}
+		if item.URL != "" {
+			lw.line("URL", item.URL)
+		}
+
+		switch component {
+		case ComponentEvent:
+			// 期日を開始・終了とする（終日の場合は翌日を終了日とする）
+			if item.AllDay {
+				lw.line("DTSTART;VALUE=DATE", item.Due.Format(dateLayout))
+				lw.line("DTEND;VALUE=DATE", item.Due.AddDate(0, 0, 1).Format(dateLayout))
+			} else {
+				lw.line("DTSTART", item.Due.UTC().Format(dateTimeLayout))
+				lw.line("DTEND", item.Due.UTC().Format(dateTimeLayout))
+			}
+			lw.line("TRANSP", "TRANSPARENT")
+		default:
+			if item.AllDay {
+				lw.line("DUE;VALUE=DATE", item.Due.Format(dateLayout))
+			} else {
+				lw.line("DUE", item.Due.UTC().Format(dateTimeLayout))
+			}
+			if item.Status != "" {
+				lw.line("STATUS", string(item.Status))
+			}
+			if item.Completed != nil {
+				lw.line("COMPLETED", item.Completed.UTC().Format(dateTimeLayout))
+			}
+		}
+
+		if item.Priority > 0 {
+			lw.line("PRIORITY", strconv.Itoa(item.Priority))
+		}
+		if len(item.Categories) > 0 {
+			categories := make([]string, len(item.Categories))
+			for i, category := range item.Categories {
+				categories[i] = escapeText(category)
+			}
+			lw.line("CATEGORIES", strings.Join(categories, ","))
+		}
+		if !item.LastModified.IsZero() {
+			lw.line("LAST-MODIFIED", item.LastModified.UTC().Format(dateTimeLayout))
+		}
+		lw.line("END", string(component))
+	}
+
+	lw.line("END", "VCALENDAR")
+
+	if lw.err != nil {
+		return lw.err
+	}
+	return bw.Flush()
+}
+
+// escapeTextはTEXT値の特殊文字をエスケープする
+func escapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// lineWriterはコンテンツ行を75オクテットで折り返してCRLFで書き出す
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) line(name, value string) {
+	if lw.err != nil {
+		return
+	}
+	content := name + ":" + value
+
+	var b strings.Builder
+	octets := 0
+	for _, r := range content {
+		size := len(string(r))
+		// マルチバイト文字の途中で折り返さない
+		if octets+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			octets = 1
+		}
+		b.WriteRune(r)
+		octets += size
+	}
+	b.WriteString("\r\n")
+
+	_, lw.err = lw.w.WriteString(b.String())
+}
@@ -0,0 +1,158 @@
+// Package markdownはタスクの説明（CommonMark）をサニタイズ済みのHTMLに変換する
+//
+// 生のHTMLは出力せず、変換後のHTMLからスクリプトや危険なURL（javascript:など）を取り除く。
+// 本文中の#123はタスクへの参照として扱い、リンクにしてよいタスクのみ/tasks/123へのリンクにする。
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// policyは変換後のHTMLに許可する要素・属性（ユーザー投稿向けの標準的な設定）
+var policy = bluemonday.UGCPolicy()
+
+// referenceParserはタスク参照だけを解析するパーサー（TaskReferences用）
+var referenceParser = newParser()
+
+// TaskReferencesは説明中のタスク参照（#123）のタスクIDを出現順に重複なく返す
+// コードやリンクのテキスト中の#123は参照としない
+func TaskReferences(source string) []int64 {
+	doc := referenceParser.Parse(text.NewReader([]byte(source)))
+
+	seen := make(map[int64]bool)
+	var ids []int64
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if ref, ok := node.(*taskReference); ok && entering && !insideLink(ref) && !seen[ref.ID] {
+			seen[ref.ID] = true
+			ids = append(ids, ref.ID)
+		}
+		return ast.WalkContinue, nil
+	})
+	return ids
+}
+
+// RenderはCommonMarkをサニタイズ済みのHTMLに変換する
+// linkableがtrueを返すタスクへの参照のみリンクにし、それ以外は#123のままテキストとして出力する
+func Render(source string, linkable func(taskID int64) bool) (string, error) {
+	md := goldmark.New(
+		goldmark.WithParser(newParser()),
+		goldmark.WithRendererOptions(
+			renderer.WithNodeRenderers(util.Prioritized(&taskReferenceRenderer{linkable: linkable}, 500)),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	return policy.Sanitize(buf.String()), nil
+}
+
+// newParserはCommonMarkにタスク参照の解析を加えたパーサーを作成
+func newParser() parser.Parser {
+	p := goldmark.DefaultParser()
+	p.AddOptions(parser.WithInlineParsers(util.Prioritized(&taskReferenceParser{}, 500)))
+	return p
+}
+
+// kindTaskReferenceはタスク参照のノードの種類
+var kindTaskReference = ast.NewNodeKind("TaskReference")
+
+// taskReferenceはタスク参照（#123）のノード
+type taskReference struct {
+	ast.BaseInline
+	ID int64
+}
+
+// Kindはノードの種類を返す
+func (n *taskReference) Kind() ast.NodeKind {
+	return kindTaskReference
+}
+
+// Dumpはデバッグ用にノードの内容を出力する
+func (n *taskReference) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": strconv.FormatInt(n.ID, 10)}, nil)
+}
+
+// taskReferenceParserは#に続く数字をタスク参照として解析する
+type taskReferenceParser struct{}
+
+// Triggerは解析を始める文字を返す
+func (p *taskReferenceParser) Trigger() []byte {
+	return []byte{'#'}
+}
+
+// Parseは#123をタスク参照として解析する
+// 直前・直後が英数字の場合（abc#1、#12abcなど）や&#123;のような文字参照は参照としない
+func (p *taskReferenceParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	if prev := block.PrecendingCharacter(); isWordRune(prev) || prev == '&' {
+		return nil
+	}
+
+	line, _ := block.PeekLine()
+	end := 1
+	for end < len(line) && line[end] >= '0' && line[end] <= '9' {
+		end++
+	}
+	if end == 1 || (end < len(line) && (isWordRune(rune(line[end])) || line[end] == ';')) {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(string(line[1:end]), 10, 64)
+	if err != nil || id <= 0 {
+		return nil
+	}
+
+	block.Advance(end)
+	return &taskReference{ID: id}
+}
+
+// taskReferenceRendererはタスク参照をリンクまたはテキストとして出力する
+type taskReferenceRenderer struct {
+	linkable func(taskID int64) bool
+}
+
+// RegisterFuncsはタスク参照の出力処理を登録する
+func (r *taskReferenceRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindTaskReference, r.render)
+}
+
+// renderはタスク参照を出力する（リンクのテキスト中ではリンクを入れ子にしない）
+func (r *taskReferenceRenderer) render(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	ref := node.(*taskReference)
+	if !insideLink(ref) && r.linkable(ref.ID) {
+		_, _ = fmt.Fprintf(w, `<a href="/tasks/%d">#%d</a>`, ref.ID, ref.ID)
+	} else {
+		_, _ = fmt.Fprintf(w, "#%d", ref.ID)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+// insideLinkはノードがリンク・自動リンクの中にあるかを判定
+func insideLink(node ast.Node) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Kind() == ast.KindLink || parent.Kind() == ast.KindAutoLink {
+			return true
+		}
+	}
+	return false
+}
+
+// isWordRuneは英数字・アンダースコアかどうかを判定
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,279 @@
+// Package quickaddは1行の入力からタスクのタイトル・期日・優先度・メンション・ラベルを取り出す
+//
+// 書式:
+//   - 優先度: !0〜!5
+//   - メンション: @alice または @alice@example.com（英数字と._-+）
+//   - ラベル: #backend
+//   - 日付: today, tomorrow, friday, fri, next friday, 2025-10-25, 10/25, 今日, 明日, 明後日, 金曜, 今週金曜, 来週金曜, 再来週金曜, 10月25日
+//   - 時刻: 5pm, 5:30pm, 17:00, at 5pm, 17時, 17時30分, 5時半, 午後5時
+//
+// 日付・時刻は基準時刻（ユーザーのタイムゾーン）から解決し、取り出した部分を除いた残りをタイトルとする。
+// 日付・時刻・優先度は最初に見つかったものだけを使い、2つ目以降はタイトルに残す。
+package quickadd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resultは解析結果
+type Result struct {
+	Title string
+	// DueDateは期日（DueAllDayの場合は日付をUTCの0時で表し、それ以外は基準時刻のタイムゾーンでの日時）
+	DueDate   *time.Time
+	DueAllDay bool
+	Priority  *int
+	Mentions  []string
+	Labels    []string
+}
+
+// jaParticleは日本語の日付・時刻の後に続く助詞（日付・時刻と一緒に取り除く）
+const jaParticle = `(?:までに|まで|に)?`
+
+var (
+	priorityPattern = regexp.MustCompile(`(?:^|\s)!([0-5])(?:\s|$)`)
+	mentionPattern  = regexp.MustCompile(`(?:^|\s)@([A-Za-z0-9._+\-]+(?:@[A-Za-z0-9.\-]+)?)`)
+	labelPattern    = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_\-/]+)`)
+
+	isoDatePattern     = regexp.MustCompile(`\b(\d{4})-(\d{1,2})-(\d{1,2})\b`)
+	slashDatePattern   = regexp.MustCompile(`\b(\d{1,2})/(\d{1,2})\b`)
+	jaDatePattern      = regexp.MustCompile(`(\d{1,2})月(\d{1,2})日` + jaParticle)
+	relativeDayPattern = regexp.MustCompile(`(?i)\b(today|tomorrow)\b|(明後日|あさって|明日|あした|今日|きょう)` + jaParticle)
+	weekdayPattern     = regexp.MustCompile(`(?i)\b(?:on\s+)?(next\s+)?(monday|mon|tuesday|tues|tue|wednesday|thursday|thurs|thu|friday|fri|saturday|sunday)\b`)
+	jaWeekdayPattern   = regexp.MustCompile(`(今週|来週|再来週)?の?([月火水木金土日])曜日?` + jaParticle)
+
+	clockPattern   = regexp.MustCompile(`(?i)\b(?:at\s+)?(\d{1,2})(?::(\d{2}))?\s*(am|pm)\b`)
+	hhmmPattern    = regexp.MustCompile(`(?i)\b(?:at\s+)?(\d{1,2}):(\d{2})\b`)
+	jaClockPattern = regexp.MustCompile(`(午前|午後)?(\d{1,2})時(?:(\d{1,2})分|(半))?` + jaParticle)
+)
+
+// weekdaysは英語の曜日名と曜日の対応（英単語と紛らわしいsat・sun・wedの略称は使わない）
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"mon":       time.Monday,
+	"tuesday":   time.Tuesday,
+	"tues":      time.Tuesday,
+	"tue":       time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"thurs":     time.Thursday,
+	"thu":       time.Thursday,
+	"friday":    time.Friday,
+	"fri":       time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// jaWeekdaysは日本語の曜日と曜日の対応
+var jaWeekdays = map[string]time.Weekday{
+	"日": time.Sunday, "月": time.Monday, "火": time.Tuesday, "水": time.Wednesday,
+	"木": time.Thursday, "金": time.Friday, "土": time.Saturday,
+}
+
+// Parseは入力を解析する（nowのタイムゾーンで日付・時刻を解決する）
+//
+// 曜日のみの指定は今日を含む直近のその曜日、「来週」「next」は翌週（月曜日から）のその曜日とする。
+// 年のない日付が今日より前の場合は翌年とする。時刻のみの指定は今日のその時刻（過ぎている場合は明日）とする。
+func Parse(input string, now time.Time) Result {
+	text := " " + input + " "
+	var result Result
+
+	if m := priorityPattern.FindStringSubmatchIndex(text); m != nil {
+		priority, _ := strconv.Atoi(text[m[2]:m[3]])
+		result.Priority = &priority
+		text = cut(text, m[0], m[1])
+	}
+
+	for {
+		m := mentionPattern.FindStringSubmatchIndex(text)
+		if m == nil {
+			break
+		}
+		result.Mentions = appendUnique(result.Mentions, strings.ToLower(text[m[2]:m[3]]))
+		text = cut(text, m[0], m[1])
+	}
+	for {
+		m := labelPattern.FindStringSubmatchIndex(text)
+		if m == nil {
+			break
+		}
+		result.Labels = appendUnique(result.Labels, strings.ToLower(text[m[2]:m[3]]))
+		text = cut(text, m[0], m[1])
+	}
+
+	// 数字を含む日付は時刻より先に取り出す（10/25の25を時刻と解釈しないため）
+	date, text := parseDate(text, now)
+	clock, text := parseClock(text)
+
+	switch {
+	case date != nil && clock != nil:
+		due := time.Date(date.Year(), date.Month(), date.Day(), clock.hour, clock.minute, 0, 0, now.Location())
+		result.DueDate = &due
+	case date != nil:
+		due := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		result.DueDate = &due
+		result.DueAllDay = true
+	case clock != nil:
+		due := time.Date(now.Year(), now.Month(), now.Day(), clock.hour, clock.minute, 0, 0, now.Location())
+		if !due.After(now) {
+			due = due.AddDate(0, 0, 1)
+		}
+		result.DueDate = &due
+	}
+
+	result.Title = strings.Join(strings.Fields(text), " ")
+	return result
+}
+
+// timeOfDayは時刻
+type timeOfDay struct {
+	hour, minute int
+}
+
+// parseDateは最初に見つかった日付を取り出し、その日付（nowのタイムゾーンでの0時）と残りのテキストを返す
+func parseDate(text string, now time.Time) (*time.Time, string) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if m := isoDatePattern.FindStringSubmatchIndex(text); m != nil {
+		year, _ := strconv.Atoi(text[m[2]:m[3]])
+		if date, ok := validDate(year, text[m[4]:m[5]], text[m[6]:m[7]], now.Location()); ok {
+			return &date, cut(text, m[0], m[1])
+		}
+	}
+
+	for _, pattern := range []*regexp.Regexp{jaDatePattern, slashDatePattern} {
+		m := pattern.FindStringSubmatchIndex(text)
+		if m == nil {
+			continue
+		}
+		date, ok := validDate(today.Year(), text[m[2]:m[3]], text[m[4]:m[5]], now.Location())
+		if !ok {
+			continue
+		}
+		if date.Before(today) {
+			date = date.AddDate(1, 0, 0)
+		}
+		return &date, cut(text, m[0], m[1])
+	}
+
+	if m := relativeDayPattern.FindStringSubmatchIndex(text); m != nil {
+		var word string
+		if m[2] >= 0 {
+			word = strings.ToLower(text[m[2]:m[3]])
+		} else {
+			word = text[m[4]:m[5]]
+		}
+		days := 0
+		switch word {
+		case "tomorrow", "明日", "あした":
+			days = 1
+		case "明後日", "あさって":
+			days = 2
+		}
+		date := today.AddDate(0, 0, days)
+		return &date, cut(text, m[0], m[1])
+	}
+
+	if m := jaWeekdayPattern.FindStringSubmatchIndex(text); m != nil {
+		weeks := -1
+		if m[2] >= 0 {
+			weeks = map[string]int{"今週": 0, "来週": 1, "再来週": 2}[text[m[2]:m[3]]]
+		}
+		date := resolveWeekday(today, jaWeekdays[text[m[4]:m[5]]], weeks)
+		return &date, cut(text, m[0], m[1])
+	}
+
+	if m := weekdayPattern.FindStringSubmatchIndex(text); m != nil {
+		weeks := -1
+		if m[2] >= 0 {
+			weeks = 1
+		}
+		date := resolveWeekday(today, weekdays[strings.ToLower(text[m[4]:m[5]])], weeks)
+		return &date, cut(text, m[0], m[1])
+	}
+
+	return nil, text
+}
+
+// parseClockは最初に見つかった時刻を取り出し、その時刻と残りのテキストを返す
+func parseClock(text string) (*timeOfDay, string) {
+	if m := clockPattern.FindStringSubmatchIndex(text); m != nil {
+		hour, _ := strconv.Atoi(text[m[2]:m[3]])
+		minute := 0
+		if m[4] >= 0 {
+			minute, _ = strconv.Atoi(text[m[4]:m[5]])
+		}
+		if hour >= 1 && hour <= 12 && minute < 60 {
+			hour %= 12
+			if strings.EqualFold(text[m[6]:m[7]], "pm") {
+				hour += 12
+			}
+			return &timeOfDay{hour, minute}, cut(text, m[0], m[1])
+		}
+	}
+
+	if m := hhmmPattern.FindStringSubmatchIndex(text); m != nil {
+		hour, _ := strconv.Atoi(text[m[2]:m[3]])
+		minute, _ := strconv.Atoi(text[m[4]:m[5]])
+		if hour < 24 && minute < 60 {
+			return &timeOfDay{hour, minute}, cut(text, m[0], m[1])
+		}
+	}
+
+	if m := jaClockPattern.FindStringSubmatchIndex(text); m != nil {
+		hour, _ := strconv.Atoi(text[m[4]:m[5]])
+		minute := 0
+		switch {
+		case m[6] >= 0:
+			minute, _ = strconv.Atoi(text[m[6]:m[7]])
+		case m[8] >= 0:
+			minute = 30
+		}
+		if m[2] >= 0 && text[m[2]:m[3]] == "午後" && hour < 12 {
+			hour += 12
+		}
+		if hour < 24 && minute < 60 {
+			return &timeOfDay{hour, minute}, cut(text, m[0], m[1])
+		}
+	}
+
+	return nil, text
+}
+
+// resolveWeekdayは曜日の日付を返す
+// weeksが負の場合は今日を含む直近のその曜日、それ以外は今日からweeks週後の週（月曜日から）のその曜日
+func resolveWeekday(today time.Time, weekday time.Weekday, weeks int) time.Time {
+	if weeks < 0 {
+		return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7)
+	}
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, 7*weeks+(int(weekday)+6)%7)
+}
+
+// validDateは年月日が存在する日付の場合にその日付を返す
+func validDate(year int, month, day string, loc *time.Location) (time.Time, bool) {
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	date := time.Date(year, time.Month(m), d, 0, 0, 0, 0, loc)
+	if date.Month() != time.Month(m) || date.Day() != d {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// cutはテキストの[start, end)を空白に置き換える（前後の語がつながらないように）
+func cut(text string, start, end int) string {
+	return text[:start] + " " + text[end:]
+}
+
+// appendUniqueは重複しない場合のみ値を追加する
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
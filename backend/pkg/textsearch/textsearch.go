@@ -0,0 +1,157 @@
+// Package textsearchは全文検索用のトークン分割とスニペットのハイライトを提供する
+package textsearch
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Termsは検索クエリを空白で区切り、小文字化した検索語を返す（重複は除く）
+func Terms(query string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, field := range strings.Fields(query) {
+		term := strings.ToLower(field)
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Tokenizeはテキストを索引用のトークンに分割する
+// 英数字は単語単位、日本語などの分かち書きしない文字は2文字ずつ（MySQLのngramパーサーと同じ）に分割する
+func Tokenize(text string) []string {
+	var tokens []string
+	var word, cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			tokens = append(tokens, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i+1 < len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return tokens
+}
+
+// isCJKは分かち書きしない文字（漢字・ひらがな・カタカナ・ハングル）かどうかを判定
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+// Highlightはテキストのうち最初に検索語が現れる付近を最大maxRunes文字切り出し、
+// HTMLエスケープした上で一致箇所を<mark>で囲んで返す（一致しない場合は先頭から切り出す）
+func Highlight(text string, terms []string, maxRunes int) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// 一致箇所を[start, end)の区間として記録する
+	matched := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		t := []rune(term)
+		if len(t) == 0 {
+			continue
+		}
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if !hasPrefix(lower[i:], t) {
+				continue
+			}
+			for j := i; j < i+len(t); j++ {
+				matched[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start, end := 0, len(runes)
+	if maxRunes > 0 && len(runes) > maxRunes {
+		if first > 0 {
+			// 一致箇所の前に少し文脈を残す
+			start = first - maxRunes/4
+			if start < 0 {
+				start = 0
+			}
+		}
+		end = start + maxRunes
+		if end > len(runes) {
+			end = len(runes)
+			start = end - maxRunes
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	inMark := false
+	for i := start; i < end; i++ {
+		if matched[i] && !inMark {
+			b.WriteString("<mark>")
+			inMark = true
+		}
+		if !matched[i] && inMark {
+			b.WriteString("</mark>")
+			inMark = false
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if inMark {
+		b.WriteString("</mark>")
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// hasPrefixはsがprefixで始まるかを判定
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+// Package useragentはUser-Agentヘッダーからセッション一覧に表示する端末の説明を作成する
+package useragent
+
+import "strings"
+
+// rule はUser-Agentに含まれる文字列と表示名の対応
+type rule struct {
+	token string
+	name  string
+}
+
+// browsers は判定する順序に並べたブラウザ・クライアント
+// ChromeのUser-AgentにはSafari、EdgeやOperaのUser-AgentにはChromeも含まれるため、固有のものから判定する
+var browsers = []rule{
+	{token: "edg/", name: "Edge"},
+	{token: "opr/", name: "Opera"},
+	{token: "firefox/", name: "Firefox"},
+	{token: "fxios/", name: "Firefox"},
+	{token: "crios/", name: "Chrome"},
+	{token: "chrome/", name: "Chrome"},
+	{token: "safari/", name: "Safari"},
+	{token: "curl/", name: "curl"},
+	{token: "postmanruntime/", name: "Postman"},
+}
+
+// systems は判定する順序に並べたOS
+// AndroidのUser-AgentにはLinux、iOSのUser-AgentにはMac OS Xも含まれるため、先に判定する
+var systems = []rule{
+	{token: "android", name: "Android"},
+	{token: "iphone", name: "iOS"},
+	{token: "ipad", name: "iPadOS"},
+	{token: "windows", name: "Windows"},
+	{token: "mac os x", name: "macOS"},
+	{token: "cros", name: "ChromeOS"},
+	{token: "linux", name: "Linux"},
+}
+
+// Describeは「Chrome on macOS」のような端末の説明を返す（判定できない場合は「Unknown device」）
+func Describe(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	browser := match(ua, browsers)
+	system := match(ua, systems)
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	default:
+		return "Unknown device"
+	}
+}
+
+// match はUser-Agentに最初に一致した表示名を返す
+func match(ua string, rules []rule) string {
+	for _, r := range rules {
+		if strings.Contains(ua, r.token) {
+			return r.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestUser_VerifyEmail(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	user, err := domain.NewUser(clock, "test@example.com", "Test")
+	if err != nil {
+		t.Fatalf("NewUser() error = %v", err)
+	}
+	if user.IsEmailVerified() {
+		t.Fatal("new user must not be verified")
+	}
+
+	user.VerifyEmail(clock)
+	if !user.IsEmailVerified() || !user.EmailVerifiedAt.Equal(clock.now) {
+		t.Errorf("EmailVerifiedAt = %v, want %v", user.EmailVerifiedAt, clock.now)
+	}
+
+	// 確認済みの場合は日時を変えない
+	verifiedAt := *user.EmailVerifiedAt
+	clock.now = clock.now.Add(time.Hour)
+	user.VerifyEmail(clock)
+	if !user.EmailVerifiedAt.Equal(verifiedAt) {
+		t.Errorf("EmailVerifiedAt = %v, want unchanged %v", user.EmailVerifiedAt, verifiedAt)
+	}
+}
+
+func TestUnverifiedUserPolicy(t *testing.T) {
+	verifiedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	verified := &domain.User{ID: 1, EmailVerifiedAt: &verifiedAt}
+	unverified := &domain.User{ID: 2}
+
+	tests := []struct {
+		name          string
+		policy        domain.UnverifiedUserPolicy
+		valid         bool
+		canLogin      bool
+		canBeAssigned bool
+	}{
+		{name: "none", policy: domain.UnverifiedUserPolicyNone, valid: true, canLogin: true, canBeAssigned: true},
+		{name: "no_assign", policy: domain.UnverifiedUserPolicyNoAssign, valid: true, canLogin: true, canBeAssigned: false},
+		{name: "no_login", policy: domain.UnverifiedUserPolicyNoLogin, valid: true, canLogin: false, canBeAssigned: false},
+		{name: "未定義のポリシー", policy: "strict", valid: false, canLogin: true, canBeAssigned: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+			if got := tt.policy.CanLogin(unverified); got != tt.canLogin {
+				t.Errorf("CanLogin(unverified) = %v, want %v", got, tt.canLogin)
+			}
+			if got := tt.policy.CanBeAssigned(unverified); got != tt.canBeAssigned {
+				t.Errorf("CanBeAssigned(unverified) = %v, want %v", got, tt.canBeAssigned)
+			}
+			// 確認済みのユーザーはどのポリシーでも制限しない
+			if !tt.policy.CanLogin(verified) || !tt.policy.CanBeAssigned(verified) {
+				t.Error("verified user must not be restricted")
+			}
+		})
+	}
+}
+
+func TestEmailVerification_Verify(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	user := &domain.User{ID: 1, Email: "test@example.com"}
+	verification := domain.NewEmailVerification(clock, user, 24*time.Hour)
+
+	tests := []struct {
+		name    string
+		user    *domain.User
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "期限内", user: user, now: clock.now.Add(23 * time.Hour)},
+		{name: "期限切れ", user: user, now: clock.now.Add(24 * time.Hour), wantErr: true},
+		{name: "他のユーザー", user: &domain.User{ID: 2, Email: "test@example.com"}, now: clock.now, wantErr: true},
+		{name: "メールアドレスが変わった", user: &domain.User{ID: 1, Email: "new@example.com"}, now: clock.now, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verification.Verify(tt.user, tt.now)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidVerificationToken) {
+				t.Errorf("Verify() error = %v, want ErrInvalidVerificationToken", err)
+			}
+		})
+	}
+}
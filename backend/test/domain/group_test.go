@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewGroup(t *testing.T) {
+	clock := &mockClock{}
+
+	tests := []struct {
+		name      string
+		groupName string
+		wantError error
+	}{
+		{name: "正常なグループ作成", groupName: "QAチーム", wantError: nil},
+		{name: "グループ名が空", groupName: "  ", wantError: domain.ErrInvalidGroupName},
+		{name: "グループ名が長すぎる", groupName: strings.Repeat("a", 101), wantError: domain.ErrGroupNameTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := domain.NewGroup(clock, 1, tt.groupName)
+			if err != tt.wantError {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+			if tt.wantError == nil && group.CreatedBy != 1 {
+				t.Errorf("CreatedBy = %v, want %v", group.CreatedBy, 1)
+			}
+		})
+	}
+}
+
+func TestGroup_Rename(t *testing.T) {
+	clock := &mockClock{}
+	group, _ := domain.NewGroup(clock, 1, "QAチーム")
+
+	if err := group.Rename(clock, " 品質保証チーム "); err != nil {
+		t.Fatalf("エラーが期待されていませんでした: %v", err)
+	}
+	if group.Name != "品質保証チーム" {
+		t.Errorf("Name = %v, want %v", group.Name, "品質保証チーム")
+	}
+
+	if err := group.Rename(clock, ""); err != domain.ErrInvalidGroupName {
+		t.Errorf("err = %v, want %v", err, domain.ErrInvalidGroupName)
+	}
+}
+
+func TestCanManageGroup(t *testing.T) {
+	clock := &mockClock{}
+	group, _ := domain.NewGroup(clock, 1, "QAチーム")
+
+	if !domain.CanManageGroup(group, 1) {
+		t.Error("作成者は管理可能であるべきです")
+	}
+	if domain.CanManageGroup(group, 2) {
+		t.Error("作成者以外は管理不可であるべきです")
+	}
+}
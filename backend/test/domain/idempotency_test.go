@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewIdempotencyRecord(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name      string
+		key       string
+		wantError error
+	}{
+		{name: "UUIDのキー", key: "3f0c8a8e-4b1e-4c55-9a43-1b7e2f0d9c11"},
+		{name: "キーが空", key: "", wantError: domain.ErrInvalidIdempotencyKey},
+		{name: "キーが長すぎる", key: strings.Repeat("a", 256), wantError: domain.ErrInvalidIdempotencyKey},
+		{name: "キーに空白を含む", key: "retry 1", wantError: domain.ErrInvalidIdempotencyKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := domain.NewIdempotencyRecord(clock, 1, tt.key, "fp", time.Hour)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+			if tt.wantError != nil {
+				return
+			}
+			if record.IsCompleted() {
+				t.Error("作成直後の記録が処理済みになっています")
+			}
+			if !record.ExpiresAt.Equal(clock.now.Add(time.Hour)) {
+				t.Errorf("ExpiresAt = %v, want %v", record.ExpiresAt, clock.now.Add(time.Hour))
+			}
+		})
+	}
+}
+
+func TestIdempotencyRecord_Match(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	fingerprint := domain.IdempotencyFingerprint("POST", "/api/v1/tasks", []byte(`{"title":"a"}`))
+
+	t.Run("処理中のリトライ", func(t *testing.T) {
+		record, _ := domain.NewIdempotencyRecord(clock, 1, "key", fingerprint, time.Hour)
+		if err := record.Match(fingerprint); !errors.Is(err, domain.ErrIdempotencyKeyInUse) {
+			t.Errorf("err = %v, want ErrIdempotencyKeyInUse", err)
+		}
+	})
+
+	t.Run("処理済みのリトライ", func(t *testing.T) {
+		record, _ := domain.NewIdempotencyRecord(clock, 1, "key", fingerprint, time.Hour)
+		record.Complete(201, map[string]string{"Content-Type": "application/json"}, []byte(`{"id":1}`))
+		if err := record.Match(fingerprint); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+	})
+
+	t.Run("異なるボディでキーを再利用", func(t *testing.T) {
+		record, _ := domain.NewIdempotencyRecord(clock, 1, "key", fingerprint, time.Hour)
+		record.Complete(201, nil, nil)
+		other := domain.IdempotencyFingerprint("POST", "/api/v1/tasks", []byte(`{"title":"b"}`))
+		if err := record.Match(other); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+			t.Errorf("err = %v, want ErrIdempotencyKeyReused", err)
+		}
+	})
+
+	t.Run("異なるエンドポイントでキーを再利用", func(t *testing.T) {
+		record, _ := domain.NewIdempotencyRecord(clock, 1, "key", fingerprint, time.Hour)
+		record.Complete(201, nil, nil)
+		other := domain.IdempotencyFingerprint("POST", "/api/v1/views", []byte(`{"title":"a"}`))
+		if err := record.Match(other); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+			t.Errorf("err = %v, want ErrIdempotencyKeyReused", err)
+		}
+	})
+}
+
+func TestIdempotencyRecord_CompleteWithResource(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	fingerprint := domain.IdempotencyFingerprint("POST", "/api/v1/auth/signup", []byte(`["a@example.com","A",""]`))
+	record, _ := domain.NewIdempotencyRecord(clock, 0, "key", fingerprint, time.Hour)
+
+	record.CompleteWithResource(201, 42)
+
+	if err := record.Match(fingerprint); err != nil {
+		t.Errorf("err = %v, want nil", err)
+	}
+	if record.ResourceID == nil || *record.ResourceID != 42 {
+		t.Errorf("ResourceID = %v, want 42", record.ResourceID)
+	}
+	// トークンなどを含むレスポンスは保存しない
+	if record.ResponseBody != nil || record.ResponseHeaders != nil {
+		t.Errorf("response must not be stored: headers=%v, body=%q", record.ResponseHeaders, record.ResponseBody)
+	}
+}
+
+func TestIdempotencyRecord_IsExpired(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	record, _ := domain.NewIdempotencyRecord(clock, 1, "key", "fp", time.Hour)
+
+	if record.IsExpired(clock.now.Add(59 * time.Minute)) {
+		t.Error("有効期限内なのに期限切れと判定されました")
+	}
+	if !record.IsExpired(clock.now.Add(time.Hour)) {
+		t.Error("有効期限を過ぎているのに期限切れと判定されませんでした")
+	}
+}
@@ -0,0 +1,51 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewPasswordResetToken(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	token, plain, err := domain.NewPasswordResetToken(clock, 1, time.Hour)
+	if err != nil {
+		t.Fatalf("NewPasswordResetToken() error = %v", err)
+	}
+	if plain == "" || token.TokenHash != domain.HashPasswordResetToken(plain) || token.TokenHash == plain {
+		t.Errorf("TokenHash = %q, want hash of %q", token.TokenHash, plain)
+	}
+	if !token.ExpiresAt.Equal(clock.now.Add(time.Hour)) {
+		t.Errorf("ExpiresAt = %v, want %v", token.ExpiresAt, clock.now.Add(time.Hour))
+	}
+
+	other, otherPlain, _ := domain.NewPasswordResetToken(clock, 1, time.Hour)
+	if otherPlain == plain || other.TokenHash == token.TokenHash {
+		t.Error("each token must be unique")
+	}
+}
+
+func TestPasswordResetToken_IsUsable(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		token domain.PasswordResetToken
+		want  bool
+	}{
+		{name: "未使用かつ期限内", token: domain.PasswordResetToken{ExpiresAt: now.Add(time.Minute)}, want: true},
+		{name: "期限切れ", token: domain.PasswordResetToken{ExpiresAt: now}, want: false},
+		{name: "使用済み", token: domain.PasswordResetToken{ExpiresAt: now.Add(time.Minute), UsedAt: &past}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.IsUsable(now); got != tt.want {
+				t.Errorf("IsUsable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,10 +11,13 @@ func TestCanViewTask(t *testing.T) {
 	task, _ := domain.NewTask(clock, 1, "test task")
 
 	tests := []struct {
-		name      string
-		assignees []*domain.TaskAssignee
-		userID    int64
-		want      bool
+		name           string
+		assignees      []*domain.TaskAssignee
+		groupAssignees []*domain.TaskGroupAssignee
+		userGroupIDs   []int64
+		shares         []*domain.TaskShare
+		userID         int64
+		want           bool
 	}{
 		{
 			name:      "オーナーは閲覧可能",
@@ -36,11 +39,37 @@ func TestCanViewTask(t *testing.T) {
 			userID:    999,
 			want:      false,
 		},
+		{
+			name: "アサインされたグループのメンバーは閲覧可能",
+			groupAssignees: []*domain.TaskGroupAssignee{
+				{GroupID: 10},
+			},
+			userGroupIDs: []int64{5, 10},
+			userID:       3,
+			want:         true,
+		},
+		{
+			name: "アサインされていないグループのメンバーは閲覧不可",
+			groupAssignees: []*domain.TaskGroupAssignee{
+				{GroupID: 10},
+			},
+			userGroupIDs: []int64{5},
+			userID:       3,
+			want:         false,
+		},
+		{
+			name: "共有先は閲覧可能",
+			shares: []*domain.TaskShare{
+				{UserID: 4, Permission: domain.SharePermissionView},
+			},
+			userID: 4,
+			want:   true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := domain.CanViewTask(task, tt.assignees, tt.userID)
+			got := domain.CanViewTask(task, tt.assignees, tt.groupAssignees, tt.userGroupIDs, tt.shares, tt.userID)
 			if got != tt.want {
 				t.Errorf("CanViewTask() = %v, want %v", got, tt.want)
 			}
@@ -0,0 +1,71 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	session := domain.NewSession(clock, 1, "curl/8.7.1", "192.0.2.1", 24*time.Hour)
+	session.ID = 10
+
+	t.Run("セッションのトークンを発行", func(t *testing.T) {
+		token, plain, err := domain.NewRefreshToken(clock, session)
+		if err != nil {
+			t.Fatalf("NewRefreshToken() error = %v", err)
+		}
+		if plain == "" || token.TokenHash != domain.HashRefreshToken(plain) {
+			t.Errorf("TokenHash = %q, want hash of %q", token.TokenHash, plain)
+		}
+		if token.TokenHash == plain {
+			t.Error("TokenHash must not be the plain token")
+		}
+		if token.UserID != 1 || token.SessionID != 10 {
+			t.Errorf("UserID, SessionID = %d, %d, want 1, 10", token.UserID, token.SessionID)
+		}
+		if !token.ExpiresAt.Equal(session.ExpiresAt) {
+			t.Errorf("ExpiresAt = %v, want %v", token.ExpiresAt, session.ExpiresAt)
+		}
+	})
+
+	t.Run("ローテーションでは同じセッションの別のトークン", func(t *testing.T) {
+		first, firstPlain, _ := domain.NewRefreshToken(clock, session)
+		second, secondPlain, err := domain.NewRefreshToken(clock, session)
+		if err != nil {
+			t.Fatalf("NewRefreshToken() error = %v", err)
+		}
+		if secondPlain == firstPlain || second.TokenHash == first.TokenHash {
+			t.Error("rotated token must differ from the previous token")
+		}
+	})
+}
+
+func TestRefreshToken_State(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		token   domain.RefreshToken
+		expired bool
+		rotated bool
+	}{
+		{name: "期限内", token: domain.RefreshToken{ExpiresAt: now.Add(time.Hour)}},
+		{name: "期限切れ", token: domain.RefreshToken{ExpiresAt: now}, expired: true},
+		{name: "置き換え済み", token: domain.RefreshToken{ExpiresAt: now.Add(time.Hour), RotatedAt: &past}, rotated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.IsExpired(now); got != tt.expired {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.expired)
+			}
+			if got := tt.token.IsRotated(); got != tt.rotated {
+				t.Errorf("IsRotated() = %v, want %v", got, tt.rotated)
+			}
+		})
+	}
+}
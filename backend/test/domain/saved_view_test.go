@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewSavedView(t *testing.T) {
+	clock := &mockClock{}
+	overdue := true
+
+	tests := []struct {
+		name      string
+		viewName  string
+		filter    domain.TaskFilter
+		wantError error
+	}{
+		{name: "正常なビュー", viewName: "期限切れの高優先度", filter: domain.TaskFilter{Overdue: &overdue, PriorityMin: intPtr(4)}},
+		{name: "名前が空", viewName: "  ", wantError: domain.ErrInvalidViewName},
+		{name: "名前が長すぎる", viewName: strings.Repeat("あ", 101), wantError: domain.ErrViewNameTooLong},
+		{name: "絞り込み条件が不正", viewName: "不正", filter: domain.TaskFilter{SortKey: "TITLE"}, wantError: domain.ErrInvalidSortKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, err := domain.NewSavedView(clock, 1, tt.viewName, tt.filter)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+			if tt.wantError == nil && view.Filter.SortKey != domain.TaskSortCreatedAt {
+				t.Errorf("SortKey = %v, want %v", view.Filter.SortKey, domain.TaskSortCreatedAt)
+			}
+		})
+	}
+
+	t.Run("実行時の基準時刻は保存しない", func(t *testing.T) {
+		view, err := domain.NewSavedView(clock, 1, "今日", domain.TaskFilter{Now: time.Now(), Location: time.UTC})
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if !view.Filter.Now.IsZero() || view.Filter.Location != nil {
+			t.Errorf("Now = %v, Location = %v", view.Filter.Now, view.Filter.Location)
+		}
+	})
+}
+
+func TestCanUseSavedView(t *testing.T) {
+	clock := &mockClock{}
+	view, _ := domain.NewSavedView(clock, 1, "自分のタスク", domain.TaskFilter{})
+	view.ID = 5
+
+	share, err := domain.NewSavedViewShare(clock, view, 2, 1)
+	if err != nil {
+		t.Fatalf("NewSavedViewShare() error = %v", err)
+	}
+	shares := []*domain.SavedViewShare{share}
+
+	tests := []struct {
+		name   string
+		userID int64
+		want   bool
+	}{
+		{name: "オーナー", userID: 1, want: true},
+		{name: "共有先", userID: 2, want: true},
+		{name: "共有されていないユーザー", userID: 3, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.CanUseSavedView(view, shares, tt.userID); got != tt.want {
+				t.Errorf("CanUseSavedView() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("オーナーには共有できない", func(t *testing.T) {
+		if _, err := domain.NewSavedViewShare(clock, view, 1, 1); !errors.Is(err, domain.ErrCannotShareViewWithOwner) {
+			t.Errorf("err = %v, want ErrCannotShareViewWithOwner", err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewSession(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	session := domain.NewSession(clock, 1, strings.Repeat("a", 600), "192.0.2.1", 24*time.Hour)
+
+	if len(session.UserAgent) != 512 {
+		t.Errorf("len(UserAgent) = %d, want 512", len(session.UserAgent))
+	}
+	if !session.LastUsedAt.Equal(clock.now) || !session.ExpiresAt.Equal(clock.now.Add(24*time.Hour)) {
+		t.Errorf("LastUsedAt, ExpiresAt = %v, %v", session.LastUsedAt, session.ExpiresAt)
+	}
+	if !session.IsActive(clock.now) {
+		t.Error("new session must be active")
+	}
+}
+
+func TestSession_Touch(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	session := domain.NewSession(clock, 1, "curl/8.7.1", "192.0.2.1", time.Hour)
+
+	clock.now = clock.now.Add(30 * time.Minute)
+
+	t.Run("最終利用日時と有効期限を延長し、空の接続元は変更しない", func(t *testing.T) {
+		session.Touch(clock, "", "", time.Hour)
+		if !session.LastUsedAt.Equal(clock.now) || !session.ExpiresAt.Equal(clock.now.Add(time.Hour)) {
+			t.Errorf("LastUsedAt, ExpiresAt = %v, %v", session.LastUsedAt, session.ExpiresAt)
+		}
+		if session.UserAgent != "curl/8.7.1" || session.IPAddress != "192.0.2.1" {
+			t.Errorf("UserAgent, IPAddress = %q, %q", session.UserAgent, session.IPAddress)
+		}
+	})
+
+	t.Run("接続元を更新", func(t *testing.T) {
+		session.Touch(clock, "Mozilla/5.0", "198.51.100.2", time.Hour)
+		if session.UserAgent != "Mozilla/5.0" || session.IPAddress != "198.51.100.2" {
+			t.Errorf("UserAgent, IPAddress = %q, %q", session.UserAgent, session.IPAddress)
+		}
+	})
+}
+
+func TestSession_IsActive(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		session domain.Session
+		active  bool
+		revoked bool
+	}{
+		{name: "期限内", session: domain.Session{ExpiresAt: now.Add(time.Hour)}, active: true},
+		{name: "期限切れ", session: domain.Session{ExpiresAt: now}, active: false},
+		{name: "ログアウト済み", session: domain.Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &past}, active: false, revoked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.session.IsActive(now); got != tt.active {
+				t.Errorf("IsActive() = %v, want %v", got, tt.active)
+			}
+			if got := tt.session.IsRevoked(); got != tt.revoked {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.revoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestSLAPolicy_Evaluate(t *testing.T) {
+	created := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	policy := &domain.SLAPolicy{
+		Priority:         5,
+		StartWithin:      time.Hour,
+		ResolveWithin:    24 * time.Hour,
+		EscalateBefore:   15 * time.Minute,
+		EscalationAction: domain.SLAEscalationBoth,
+	}
+	at := func(d time.Duration) *time.Time {
+		t := created.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name           string
+		startedAt      *time.Time
+		completedAt    *time.Time
+		now            time.Time
+		wantStart      bool
+		wantResolve    bool
+		wantNearBreach bool
+	}{
+		{name: "期限まで余裕がある", now: created.Add(10 * time.Minute)},
+		{name: "着手期限が近い", now: created.Add(50 * time.Minute), wantNearBreach: true},
+		{name: "着手期限を過ぎた", now: created.Add(2 * time.Hour), wantStart: true},
+		{name: "期限内に着手済み", startedAt: at(30 * time.Minute), now: created.Add(2 * time.Hour)},
+		{name: "着手が遅れた", startedAt: at(90 * time.Minute), now: created.Add(2 * time.Hour), wantStart: true},
+		{name: "完了期限が近い", startedAt: at(30 * time.Minute), now: created.Add(23*time.Hour + 50*time.Minute), wantNearBreach: true},
+		{name: "完了期限を過ぎた", startedAt: at(30 * time.Minute), now: created.Add(25 * time.Hour), wantResolve: true},
+		{name: "期限内に完了済み", startedAt: at(30 * time.Minute), completedAt: at(3 * time.Hour), now: created.Add(48 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := domain.NewTask(&mockClock{now: created}, 1, "問い合わせ対応")
+			task.StartedAt = tt.startedAt
+			task.CompletedAt = tt.completedAt
+
+			status := policy.Evaluate(task, tt.now)
+			if status.StartBreached != tt.wantStart {
+				t.Errorf("StartBreached = %v, want %v", status.StartBreached, tt.wantStart)
+			}
+			if status.ResolveBreached != tt.wantResolve {
+				t.Errorf("ResolveBreached = %v, want %v", status.ResolveBreached, tt.wantResolve)
+			}
+			if status.NearBreach != tt.wantNearBreach {
+				t.Errorf("NearBreach = %v, want %v", status.NearBreach, tt.wantNearBreach)
+			}
+		})
+	}
+}
+
+func TestTask_ChangeStatus(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)}
+	task, _ := domain.NewTask(clock, 1, "テスト")
+
+	clock.now = clock.now.Add(time.Hour)
+	if err := task.ChangeStatus(clock, domain.TaskStatusIN_PROGRESS); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	startedAt := clock.now
+	if task.StartedAt == nil || !task.StartedAt.Equal(startedAt) {
+		t.Errorf("StartedAt = %v, want %v", task.StartedAt, startedAt)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	if err := task.ChangeStatus(clock, domain.TaskStatusDONE); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if task.CompletedAt == nil || !task.CompletedAt.Equal(clock.now) {
+		t.Errorf("CompletedAt = %v, want %v", task.CompletedAt, clock.now)
+	}
+	if !task.StartedAt.Equal(startedAt) {
+		t.Errorf("StartedAt should not change on completion, got %v", task.StartedAt)
+	}
+
+	// 再オープンで完了日時はクリアされる
+	if err := task.ChangeStatus(clock, domain.TaskStatusTODO); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if task.CompletedAt != nil {
+		t.Errorf("CompletedAt = %v, want nil", task.CompletedAt)
+	}
+
+	// 無効な遷移
+	if err := task.ChangeStatus(clock, "INVALID"); err != domain.ErrInvalidStatusTransition {
+		t.Errorf("err = %v, want %v", err, domain.ErrInvalidStatusTransition)
+	}
+}
+
+func TestTask_Escalate(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name         string
+		priority     int
+		action       domain.SLAEscalationAction
+		wantPriority int
+	}{
+		{name: "優先度を上げる", priority: 3, action: domain.SLAEscalationRaisePriority, wantPriority: 4},
+		{name: "通知のみ", priority: 3, action: domain.SLAEscalationNotifyOwner, wantPriority: 3},
+		{name: "上限を超えない", priority: 5, action: domain.SLAEscalationBoth, wantPriority: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := domain.NewTask(clock, 1, "テスト")
+			task.Priority = tt.priority
+
+			task.Escalate(clock, tt.action)
+			if task.Priority != tt.wantPriority {
+				t.Errorf("Priority = %v, want %v", task.Priority, tt.wantPriority)
+			}
+			if task.SLAEscalatedAt == nil {
+				t.Error("SLAEscalatedAt should be set")
+			}
+
+			// エスカレーション済みのタスクは再度エスカレーションしない
+			policy := &domain.SLAPolicy{StartWithin: time.Hour, ResolveWithin: time.Hour}
+			if policy.NeedsEscalation(task, clock.now.Add(2*time.Hour)) {
+				t.Error("escalated task should not need escalation again")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewTaskDependency(t *testing.T) {
+	clock := &mockClock{}
+
+	tests := []struct {
+		name            string
+		taskID          int64
+		dependsOnTaskID int64
+		wantError       error
+	}{
+		{name: "別のタスクに依存", taskID: 1, dependsOnTaskID: 2, wantError: nil},
+		{name: "自分自身には依存できない", taskID: 1, dependsOnTaskID: 1, wantError: domain.ErrSelfDependency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewTaskDependency(clock, tt.taskID, tt.dependsOnTaskID, 1)
+			if err != tt.wantError {
+				t.Errorf("err = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestWouldCreateDependencyCycle(t *testing.T) {
+	// 2 → 3 → 4 の依存関係がある状態
+	existing := []*domain.TaskDependency{
+		{TaskID: 2, DependsOnTaskID: 3},
+		{TaskID: 3, DependsOnTaskID: 4},
+	}
+
+	tests := []struct {
+		name            string
+		taskID          int64
+		dependsOnTaskID int64
+		want            bool
+	}{
+		{name: "新しい依存は循環しない", taskID: 1, dependsOnTaskID: 2, want: false},
+		{name: "直接の循環", taskID: 3, dependsOnTaskID: 2, want: true},
+		{name: "間接的な循環", taskID: 4, dependsOnTaskID: 2, want: true},
+		{name: "自分自身への依存", taskID: 2, dependsOnTaskID: 2, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.WouldCreateDependencyCycle(existing, tt.taskID, tt.dependsOnTaskID); got != tt.want {
+				t.Errorf("WouldCreateDependencyCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
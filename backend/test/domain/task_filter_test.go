@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestTaskFilter_Validate(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  domain.TaskFilter
+		wantErr error
+	}{
+		{name: "空の条件", filter: domain.TaskFilter{}},
+		{name: "すべて指定", filter: domain.TaskFilter{
+			Statuses:      []domain.TaskStatus{domain.TaskStatusTODO, domain.TaskStatusDONE},
+			PriorityMin:   intPtr(1),
+			PriorityMax:   intPtr(5),
+			DueFrom:       &from,
+			DueTo:         &to,
+			Role:          domain.TaskRoleAssigned,
+			SortKey:       domain.TaskSortDueDate,
+			SortDirection: domain.SortAsc,
+		}},
+		{name: "不正なステータス", filter: domain.TaskFilter{Statuses: []domain.TaskStatus{"DOING"}}, wantErr: domain.ErrInvalidStatus},
+		{name: "優先度が範囲外", filter: domain.TaskFilter{PriorityMax: intPtr(6)}, wantErr: domain.ErrInvalidPriority},
+		{name: "優先度の下限が上限より大きい", filter: domain.TaskFilter{PriorityMin: intPtr(4), PriorityMax: intPtr(2)}, wantErr: domain.ErrInvalidPriority},
+		{name: "期間の開始が終了以降", filter: domain.TaskFilter{CreatedFrom: &to, CreatedTo: &from}, wantErr: domain.ErrInvalidDateRange},
+		{name: "不正なロール", filter: domain.TaskFilter{Role: "SHARED"}, wantErr: domain.ErrInvalidTaskRole},
+		{name: "不正な並び替え項目", filter: domain.TaskFilter{SortKey: "TITLE"}, wantErr: domain.ErrInvalidSortKey},
+		{name: "不正な並び順", filter: domain.TaskFilter{SortDirection: "UP"}, wantErr: domain.ErrInvalidSortKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("並び順の省略時は作成日時の降順", func(t *testing.T) {
+		filter := domain.TaskFilter{}
+		if err := filter.Validate(); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		if filter.SortKey != domain.TaskSortCreatedAt || filter.SortDirection != domain.SortDesc {
+			t.Errorf("SortKey = %s, SortDirection = %s", filter.SortKey, filter.SortDirection)
+		}
+	})
+}
+
+func TestTaskFilter_OverdueBounds(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	// UTCでは10/19だが東京では10/20
+	now := time.Date(2025, 10, 19, 16, 0, 0, 0, time.UTC)
+
+	filter := domain.TaskFilter{Now: now, Location: tokyo}
+	gotNow, gotToday := filter.OverdueBounds()
+
+	if !gotNow.Equal(now) {
+		t.Errorf("now = %v, want %v", gotNow, now)
+	}
+	wantToday := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	if !gotToday.Equal(wantToday) {
+		t.Errorf("today = %v, want %v", gotToday, wantToday)
+	}
+
+	// 終日の期日の判定がTask.IsOverdueと一致すること
+	for _, due := range []time.Time{
+		time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+	} {
+		task := &domain.Task{Status: domain.TaskStatusTODO, DueDate: &due, DueAllDay: true}
+		if got, want := due.Before(gotToday), task.IsOverdue(now, tokyo); got != want {
+			t.Errorf("期日%sの判定が一致しません: filter=%v, task=%v", due.Format("2006-01-02"), got, want)
+		}
+	}
+}
+
+func TestNewTaskCursor(t *testing.T) {
+	createdAt := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+	task := &domain.Task{ID: 7, Priority: 4, DueDate: &due, CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	t.Run("並び替え項目の値とIDを保持する", func(t *testing.T) {
+		filter := domain.TaskFilter{SortKey: domain.TaskSortPriority, SortDirection: domain.SortDesc}
+		c := domain.NewTaskCursor(filter, task)
+		if c.Priority != 4 || c.ID != 7 {
+			t.Errorf("cursor = %+v", c)
+		}
+		if err := c.Validate(filter); err != nil {
+			t.Errorf("Validate() error = %v", err)
+		}
+	})
+
+	t.Run("期日なしのタスクのカーソルは期日がnil", func(t *testing.T) {
+		filter := domain.TaskFilter{SortKey: domain.TaskSortDueDate, SortDirection: domain.SortAsc}
+		c := domain.NewTaskCursor(filter, &domain.Task{ID: 8})
+		if c.Time != nil {
+			t.Errorf("Time = %v, want nil", c.Time)
+		}
+		if err := c.Validate(filter); err != nil {
+			t.Errorf("Validate() error = %v", err)
+		}
+	})
+
+	t.Run("並び順が異なる一覧には使えない", func(t *testing.T) {
+		c := domain.NewTaskCursor(domain.TaskFilter{SortKey: domain.TaskSortCreatedAt, SortDirection: domain.SortDesc}, task)
+		filter := domain.TaskFilter{SortKey: domain.TaskSortCreatedAt, SortDirection: domain.SortAsc}
+		if err := c.Validate(filter); !errors.Is(err, domain.ErrInvalidCursor) {
+			t.Errorf("Validate() error = %v, want ErrInvalidCursor", err)
+		}
+	})
+}
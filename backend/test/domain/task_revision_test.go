@@ -0,0 +1,130 @@
+package domain_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewTaskRevision(t *testing.T) {
+	clock := &mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	task, _ := domain.NewTask(clock, 1, "レポート作成")
+	task.ID = 10
+	task.Version = 3
+
+	assignees := []*domain.TaskAssignee{{TaskID: 10, UserID: 5}, {TaskID: 10, UserID: 2}}
+	groupAssignees := []*domain.TaskGroupAssignee{{TaskID: 10, GroupID: 9}, {TaskID: 10, GroupID: 4}}
+	changedBy := int64(1)
+
+	revision := domain.NewTaskRevision(clock, task, assignees, groupAssignees, &changedBy)
+
+	if revision.TaskID != 10 || revision.Revision != 3 {
+		t.Errorf("TaskID, Revision = %d, %d, want 10, 3", revision.TaskID, revision.Revision)
+	}
+	if !slices.Equal(revision.AssigneeIDs, []int64{2, 5}) {
+		t.Errorf("AssigneeIDs = %v, want [2 5]", revision.AssigneeIDs)
+	}
+	if !slices.Equal(revision.AssigneeGroupIDs, []int64{4, 9}) {
+		t.Errorf("AssigneeGroupIDs = %v, want [4 9]", revision.AssigneeGroupIDs)
+	}
+	if revision.ChangedBy == nil || *revision.ChangedBy != 1 {
+		t.Errorf("ChangedBy = %v, want 1", revision.ChangedBy)
+	}
+	if !revision.CreatedAt.Equal(clock.now) {
+		t.Errorf("CreatedAt = %v, want %v", revision.CreatedAt, clock.now)
+	}
+}
+
+func TestDiffTaskRevisions(t *testing.T) {
+	due := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	sameDue := due.In(time.FixedZone("JST", 9*60*60))
+	description := "詳細"
+
+	base := func() *domain.TaskRevision {
+		return &domain.TaskRevision{
+			Revision:    1,
+			Title:       "レポート作成",
+			DueDate:     &due,
+			Status:      domain.TaskStatusTODO,
+			AssigneeIDs: []int64{2, 5},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(r *domain.TaskRevision)
+		wantFields []domain.TaskField
+	}{
+		{
+			name:       "変更なし",
+			modify:     func(r *domain.TaskRevision) {},
+			wantFields: nil,
+		},
+		{
+			name:       "同じ時刻でタイムゾーンだけ異なる期日は変更なし",
+			modify:     func(r *domain.TaskRevision) { r.DueDate = &sameDue },
+			wantFields: nil,
+		},
+		{
+			name: "タイトルと説明の変更",
+			modify: func(r *domain.TaskRevision) {
+				r.Title = "レポート提出"
+				r.Description = &description
+			},
+			wantFields: []domain.TaskField{domain.TaskFieldTitle, domain.TaskFieldDescription},
+		},
+		{
+			name:       "終日の期日への変更",
+			modify:     func(r *domain.TaskRevision) { r.DueAllDay = true },
+			wantFields: []domain.TaskField{domain.TaskFieldDueDate},
+		},
+		{
+			name: "ステータスとアサインの変更",
+			modify: func(r *domain.TaskRevision) {
+				r.Status = domain.TaskStatusIN_PROGRESS
+				r.AssigneeIDs = []int64{2}
+			},
+			wantFields: []domain.TaskField{domain.TaskFieldStatus, domain.TaskFieldAssigneeIDs},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := base(), base()
+			to.Revision = 2
+			tt.modify(to)
+
+			changes := domain.DiffTaskRevisions(from, to)
+
+			var fields []domain.TaskField
+			for _, change := range changes {
+				fields = append(fields, change.Field)
+			}
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestDiffTaskRevisions_DueDateValue(t *testing.T) {
+	due := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	from := &domain.TaskRevision{Title: "a", DueDate: &due}
+	to := &domain.TaskRevision{Title: "a"}
+
+	changes := domain.DiffTaskRevisions(from, to)
+	if len(changes) != 1 {
+		t.Fatalf("len(changes) = %d, want 1", len(changes))
+	}
+
+	fromValue, ok := changes[0].From.(domain.TaskDueDate)
+	if !ok || fromValue.Date == nil || !fromValue.Date.Equal(due) {
+		t.Errorf("From = %#v, want TaskDueDate{Date: %v}", changes[0].From, due)
+	}
+	toValue, ok := changes[0].To.(domain.TaskDueDate)
+	if !ok || toValue.Date != nil {
+		t.Errorf("To = %#v, want TaskDueDate{Date: nil}", changes[0].To)
+	}
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestScheduleRange_AllDayBounds(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	tests := []struct {
+		name        string
+		r           domain.ScheduleRange
+		wantFromDay time.Time
+		wantToDay   time.Time
+	}{
+		{
+			name: "閲覧者のタイムゾーンの0時で区切った期間",
+			// 東京で10/20 0時〜10/27 0時
+			r: domain.ScheduleRange{
+				From:     time.Date(2025, 10, 19, 15, 0, 0, 0, time.UTC),
+				To:       time.Date(2025, 10, 26, 15, 0, 0, 0, time.UTC),
+				Location: tokyo,
+			},
+			wantFromDay: time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC),
+			wantToDay:   time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "日の途中で区切った期間",
+			// 東京で10/20 12時〜10/26 12時
+			r: domain.ScheduleRange{
+				From:     time.Date(2025, 10, 20, 3, 0, 0, 0, time.UTC),
+				To:       time.Date(2025, 10, 26, 3, 0, 0, 0, time.UTC),
+				Location: tokyo,
+			},
+			wantFromDay: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+			wantToDay:   time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "タイムゾーン未指定はUTC",
+			r: domain.ScheduleRange{
+				From: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+			},
+			wantFromDay: time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC),
+			wantToDay:   time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromDay, toDay := tt.r.AllDayBounds()
+			if !fromDay.Equal(tt.wantFromDay) {
+				t.Errorf("fromDay = %v, want %v", fromDay, tt.wantFromDay)
+			}
+			if !toDay.Equal(tt.wantToDay) {
+				t.Errorf("toDay = %v, want %v", toDay, tt.wantToDay)
+			}
+
+			// 終日の期日の判定がTask.DueDeadlineと一致すること
+			loc := tt.r.Location
+			if loc == nil {
+				loc = time.UTC
+			}
+			for due := tt.wantFromDay.AddDate(0, 0, -2); due.Before(tt.wantToDay.AddDate(0, 0, 2)); due = due.AddDate(0, 0, 1) {
+				task := &domain.Task{DueDate: &due, DueAllDay: true}
+				start := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, loc)
+				want := start.Before(tt.r.To) && !task.DueDeadline(loc).Before(tt.r.From)
+				if got := !due.Before(fromDay) && due.Before(toDay); got != want {
+					t.Errorf("期日%sの判定が一致しません: bounds=%v, deadline=%v", due.Format("2006-01-02"), got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewTaskShare(t *testing.T) {
+	clock := &mockClock{}
+	task, _ := domain.NewTask(clock, 1, "test task")
+	task.ID = 10
+
+	tests := []struct {
+		name       string
+		userID     int64
+		permission domain.SharePermission
+		wantError  error
+	}{
+		{name: "閲覧権限で共有", userID: 2, permission: domain.SharePermissionView, wantError: nil},
+		{name: "廃止したコメント権限", userID: 2, permission: "COMMENT", wantError: domain.ErrInvalidSharePermission},
+		{name: "不正な権限", userID: 2, permission: "EDIT", wantError: domain.ErrInvalidSharePermission},
+		{name: "オーナーには共有できない", userID: 1, permission: domain.SharePermissionView, wantError: domain.ErrCannotShareWithOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			share, err := domain.NewTaskShare(clock, task, tt.userID, tt.permission, 1)
+			if err != tt.wantError {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+			if tt.wantError == nil && share.TaskID != task.ID {
+				t.Errorf("TaskID = %v, want %v", share.TaskID, task.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewTaskStats(t *testing.T) {
+	stats := domain.NewTaskStats()
+
+	for _, status := range []domain.TaskStatus{domain.TaskStatusTODO, domain.TaskStatusIN_PROGRESS, domain.TaskStatusDONE} {
+		if count, ok := stats.ByStatus[status]; !ok || count != 0 {
+			t.Errorf("ByStatus[%s] = %d, %v, want 0, true", status, count, ok)
+		}
+	}
+	for priority := 0; priority <= 5; priority++ {
+		if count, ok := stats.ByPriority[priority]; !ok || count != 0 {
+			t.Errorf("ByPriority[%d] = %d, %v, want 0, true", priority, count, ok)
+		}
+	}
+}
+
+func TestNewTaskStatsBounds(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		name              string
+		now               time.Time
+		loc               *time.Location
+		wantToday         time.Time
+		wantWeekStart     time.Time
+		wantWeekStartDate time.Time
+	}{
+		{
+			name:              "水曜日（UTC）",
+			now:               time.Date(2025, 10, 22, 12, 0, 0, 0, time.UTC),
+			loc:               time.UTC,
+			wantToday:         time.Date(2025, 10, 22, 0, 0, 0, 0, time.UTC),
+			wantWeekStart:     time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+			wantWeekStartDate: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "日曜日は前の月曜日からの週",
+			now:               time.Date(2025, 10, 26, 12, 0, 0, 0, time.UTC),
+			loc:               time.UTC,
+			wantToday:         time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC),
+			wantWeekStart:     time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+			wantWeekStartDate: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "UTCでは日曜日でも東京では月曜日",
+			now:               time.Date(2025, 10, 26, 20, 0, 0, 0, time.UTC),
+			loc:               tokyo,
+			wantToday:         time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+			wantWeekStart:     time.Date(2025, 10, 26, 15, 0, 0, 0, time.UTC),
+			wantWeekStartDate: time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bounds := domain.NewTaskStatsBounds(tt.now, tt.loc)
+
+			if !bounds.Now.Equal(tt.now) {
+				t.Errorf("Now = %v, want %v", bounds.Now, tt.now)
+			}
+			if !bounds.Today.Equal(tt.wantToday) {
+				t.Errorf("Today = %v, want %v", bounds.Today, tt.wantToday)
+			}
+			if !bounds.WeekStart.Equal(tt.wantWeekStart) || !bounds.WeekEnd.Equal(tt.wantWeekStart.AddDate(0, 0, 7)) {
+				t.Errorf("WeekStart, WeekEnd = %v, %v, want %v からの7日間", bounds.WeekStart, bounds.WeekEnd, tt.wantWeekStart)
+			}
+			if !bounds.WeekStartDate.Equal(tt.wantWeekStartDate) || !bounds.WeekEndDate.Equal(tt.wantWeekStartDate.AddDate(0, 0, 7)) {
+				t.Errorf("WeekStartDate, WeekEndDate = %v, %v, want %v からの7日間", bounds.WeekStartDate, bounds.WeekEndDate, tt.wantWeekStartDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+// transitionは遷移のテストデータを作成する
+func transition(taskID int64, to domain.TaskStatus, at time.Time) *domain.TaskStatusTransition {
+	return &domain.TaskStatusTransition{TaskID: taskID, ToStatus: to, TransitionedAt: at}
+}
+
+// day は2025年10月の日時（UTC）を返す
+func day(d, hour int) time.Time {
+	return time.Date(2025, 10, d, hour, 0, 0, 0, time.UTC)
+}
+
+// analyticsFlowsは分析のテスト用のタスクの遷移
+// タスク1: 20日作成→21日着手→23日完了、タスク2: 20日作成→22日直接完了、タスク3: 21日作成→22日着手（未完了）
+// タスク4: 13日作成→14日着手→15日完了→21日再オープン（未完了）
+func analyticsFlows() []*domain.TaskFlow {
+	return domain.GroupTaskFlows([]*domain.TaskStatusTransition{
+		transition(1, domain.TaskStatusTODO, day(20, 9)),
+		transition(1, domain.TaskStatusIN_PROGRESS, day(21, 9)),
+		transition(1, domain.TaskStatusDONE, day(23, 9)),
+		transition(2, domain.TaskStatusTODO, day(20, 12)),
+		transition(2, domain.TaskStatusDONE, day(22, 12)),
+		transition(3, domain.TaskStatusTODO, day(21, 9)),
+		transition(3, domain.TaskStatusIN_PROGRESS, day(22, 9)),
+		transition(4, domain.TaskStatusTODO, day(13, 9)),
+		transition(4, domain.TaskStatusIN_PROGRESS, day(14, 9)),
+		transition(4, domain.TaskStatusDONE, day(15, 9)),
+		transition(4, domain.TaskStatusTODO, day(21, 9)),
+	})
+}
+
+func TestNewTaskAnalyticsRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		wantError error
+	}{
+		{name: "1日", from: day(20, 0), to: day(20, 0)},
+		{name: "366日", from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "367日", from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), wantError: domain.ErrInvalidDateRange},
+		{name: "開始日が終了日より後", from: day(21, 0), to: day(20, 0), wantError: domain.ErrInvalidDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewTaskAnalyticsRange(tt.from, tt.to, time.UTC)
+			if !errors.Is(err, tt.wantError) {
+				t.Errorf("err = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestTaskAnalyticsRange_Location(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	r, _ := domain.NewTaskAnalyticsRange(day(20, 0), day(26, 0), tokyo)
+
+	if want := time.Date(2025, 10, 19, 15, 0, 0, 0, time.UTC); !r.Start().Equal(want) {
+		t.Errorf("Start = %v, want %v", r.Start(), want)
+	}
+	if want := time.Date(2025, 10, 26, 15, 0, 0, 0, time.UTC); !r.End().Equal(want) {
+		t.Errorf("End = %v, want %v", r.End(), want)
+	}
+}
+
+func TestTaskFlow(t *testing.T) {
+	flows := analyticsFlows()
+	if len(flows) != 4 {
+		t.Fatalf("len(flows) = %d, want 4", len(flows))
+	}
+
+	if completedAt := flows[0].CompletedAt(); completedAt == nil || !completedAt.Equal(day(23, 9)) {
+		t.Errorf("タスク1のCompletedAt = %v, want %v", completedAt, day(23, 9))
+	}
+	if startedAt := flows[1].StartedAt(); startedAt != nil {
+		t.Errorf("直接完了したタスクのStartedAt = %v, want nil", startedAt)
+	}
+	if completedAt := flows[3].CompletedAt(); completedAt != nil {
+		t.Errorf("再オープンしたタスクのCompletedAt = %v, want nil", completedAt)
+	}
+
+	if _, ok := flows[2].StatusAt(day(21, 9)); ok {
+		t.Error("作成日時ちょうどの時点で作成済みになっています")
+	}
+	if status, ok := flows[2].StatusAt(day(22, 10)); !ok || status != domain.TaskStatusIN_PROGRESS {
+		t.Errorf("StatusAt = %v, %v, want IN_PROGRESS, true", status, ok)
+	}
+}
+
+func TestNewDurationStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      domain.DurationStats
+	}{
+		{name: "空", durations: nil, want: domain.DurationStats{}},
+		{
+			name:      "奇数件",
+			durations: []time.Duration{3 * time.Hour, time.Hour, 2 * time.Hour},
+			want:      domain.DurationStats{Count: 3, Average: 2 * time.Hour, Median: 2 * time.Hour, P85: 3 * time.Hour},
+		},
+		{
+			name:      "偶数件",
+			durations: []time.Duration{4 * time.Hour, time.Hour, 2 * time.Hour, 3 * time.Hour},
+			want:      domain.DurationStats{Count: 4, Average: 150 * time.Minute, Median: 150 * time.Minute, P85: 4 * time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.NewDurationStats(tt.durations); got != tt.want {
+				t.Errorf("NewDurationStats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeadTimesAndCycleTimes(t *testing.T) {
+	r, _ := domain.NewTaskAnalyticsRange(day(20, 0), day(26, 0), time.UTC)
+	flows := analyticsFlows()
+
+	// 期間内に完了しているのはタスク1と2（タスク4は再オープンして未完了）
+	leadTimes := domain.LeadTimes(flows, r)
+	if len(leadTimes) != 2 || leadTimes[0] != 72*time.Hour || leadTimes[1] != 48*time.Hour {
+		t.Errorf("LeadTimes = %v, want [72h 48h]", leadTimes)
+	}
+
+	// 着手せずに完了したタスク2はサイクルタイムに含まない
+	cycleTimes := domain.CycleTimes(flows, r)
+	if len(cycleTimes) != 1 || cycleTimes[0] != 48*time.Hour {
+		t.Errorf("CycleTimes = %v, want [48h]", cycleTimes)
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	// 15日（水）〜28日（火）は13日・20日・27日の3週間と重なる
+	r, _ := domain.NewTaskAnalyticsRange(day(15, 0), day(28, 0), time.UTC)
+
+	weeks := domain.Throughput(analyticsFlows(), r)
+
+	want := []struct {
+		weekStart time.Time
+		completed int
+	}{
+		{day(13, 0), 0},
+		{day(20, 0), 2},
+		{day(27, 0), 0},
+	}
+	if len(weeks) != len(want) {
+		t.Fatalf("len(weeks) = %d, want %d", len(weeks), len(want))
+	}
+	for i, w := range want {
+		if !weeks[i].WeekStart.Equal(w.weekStart) || weeks[i].Completed != w.completed {
+			t.Errorf("weeks[%d] = %v, %d, want %v, %d", i, weeks[i].WeekStart, weeks[i].Completed, w.weekStart, w.completed)
+		}
+	}
+}
+
+func TestDailyStatusSnapshots(t *testing.T) {
+	r, _ := domain.NewTaskAnalyticsRange(day(20, 0), day(23, 0), time.UTC)
+
+	snapshots := domain.DailyStatusSnapshots(analyticsFlows(), r)
+
+	want := []map[domain.TaskStatus]int{
+		{domain.TaskStatusTODO: 2, domain.TaskStatusIN_PROGRESS: 0, domain.TaskStatusDONE: 1}, // 20日
+		{domain.TaskStatusTODO: 3, domain.TaskStatusIN_PROGRESS: 1, domain.TaskStatusDONE: 0}, // 21日
+		{domain.TaskStatusTODO: 1, domain.TaskStatusIN_PROGRESS: 2, domain.TaskStatusDONE: 1}, // 22日
+		{domain.TaskStatusTODO: 1, domain.TaskStatusIN_PROGRESS: 1, domain.TaskStatusDONE: 2}, // 23日
+	}
+	if len(snapshots) != len(want) {
+		t.Fatalf("len(snapshots) = %d, want %d", len(snapshots), len(want))
+	}
+	for i, counts := range want {
+		if !snapshots[i].Date.Equal(day(20+i, 0)) {
+			t.Errorf("snapshots[%d].Date = %v, want %v", i, snapshots[i].Date, day(20+i, 0))
+		}
+		for status, count := range counts {
+			if snapshots[i].Counts[status] != count {
+				t.Errorf("snapshots[%d].Counts[%s] = %d, want %d", i, status, snapshots[i].Counts[status], count)
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ryusuke/task_app_layerx/internal/domain"
 )
@@ -52,6 +53,9 @@ func TestNewTask(t *testing.T) {
 				if task.Status != domain.TaskStatusTODO {
 					t.Errorf("Status = %v, want %v", task.Status, domain.TaskStatusTODO)
 				}
+				if task.Version != 1 {
+					t.Errorf("Version = %v, want 1", task.Version)
+				}
 			}
 		})
 	}
@@ -156,3 +160,145 @@ func TestTask_IsOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestTask_UpdateAllDayDueDate(t *testing.T) {
+	clock := &mockClock{}
+	task, _ := domain.NewTask(clock, 1, "テスト")
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	date := time.Date(2025, 10, 25, 9, 30, 0, 0, tokyo)
+	task.UpdateAllDayDueDate(clock, &date)
+
+	if !task.DueAllDay {
+		t.Fatal("DueAllDayがtrueになっていません")
+	}
+	want := time.Date(2025, 10, 25, 0, 0, 0, 0, time.UTC)
+	if !task.DueDate.Equal(want) {
+		t.Errorf("DueDate = %v, want %v", task.DueDate, want)
+	}
+
+	// 時刻指定の期日に戻すと終日フラグは解除される
+	exact := time.Date(2025, 10, 25, 17, 0, 0, 0, tokyo)
+	task.UpdateDueDate(clock, &exact)
+	if task.DueAllDay {
+		t.Error("DueAllDayがfalseになっていません")
+	}
+	if task.DueDate.Location() != time.UTC {
+		t.Errorf("DueDateがUTCで保持されていません: %v", task.DueDate.Location())
+	}
+}
+
+func TestTask_IsOverdue(t *testing.T) {
+	clock := &mockClock{}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	allDay := time.Date(2025, 10, 25, 0, 0, 0, 0, time.UTC)
+	exact := time.Date(2025, 10, 25, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		due    time.Time
+		allDay bool
+		status domain.TaskStatus
+		now    time.Time
+		loc    *time.Location
+		want   bool
+	}{
+		{
+			name:   "終日: 東京の当日23時はまだ期限内",
+			due:    allDay,
+			allDay: true,
+			now:    time.Date(2025, 10, 25, 23, 0, 0, 0, tokyo),
+			loc:    tokyo,
+			want:   false,
+		},
+		{
+			name:   "終日: 東京の翌日0時で期限切れ",
+			due:    allDay,
+			allDay: true,
+			now:    time.Date(2025, 10, 26, 0, 0, 0, 0, tokyo),
+			loc:    tokyo,
+			want:   true,
+		},
+		{
+			name:   "終日: 同じ瞬間でもニューヨークではまだ期限内",
+			due:    allDay,
+			allDay: true,
+			now:    time.Date(2025, 10, 26, 0, 0, 0, 0, tokyo),
+			loc:    newYork,
+			want:   false,
+		},
+		{
+			name: "時刻指定: 締め切りを過ぎたら期限切れ",
+			due:  exact,
+			now:  exact.Add(time.Minute),
+			loc:  tokyo,
+			want: true,
+		},
+		{
+			name:   "完了済みは期限切れにならない",
+			due:    exact,
+			status: domain.TaskStatusDONE,
+			now:    exact.Add(time.Hour),
+			loc:    tokyo,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := domain.NewTask(clock, 1, "テスト")
+			if tt.allDay {
+				task.UpdateAllDayDueDate(clock, &tt.due)
+			} else {
+				task.UpdateDueDate(clock, &tt.due)
+			}
+			if tt.status != "" {
+				task.Status = tt.status
+			}
+
+			got := task.IsOverdue(tt.now, tt.loc)
+			if got != tt.want {
+				t.Errorf("IsOverdue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_ValidateSchedule(t *testing.T) {
+	clock := &mockClock{}
+	at := func(value string) *time.Time {
+		parsed, _ := time.Parse(time.RFC3339, value)
+		return &parsed
+	}
+
+	tests := []struct {
+		name      string
+		startDate *time.Time
+		dueDate   *time.Time
+		allDay    bool
+		wantError error
+	}{
+		{name: "開始日のみ", startDate: at("2025-10-25T09:00:00Z"), wantError: nil},
+		{name: "開始日が期日より前", startDate: at("2025-10-24T09:00:00Z"), dueDate: at("2025-10-25T09:00:00Z"), wantError: nil},
+		{name: "開始日が期日より後", startDate: at("2025-10-25T10:00:00Z"), dueDate: at("2025-10-25T09:00:00Z"), wantError: domain.ErrStartAfterDue},
+		{name: "終日の期日の当日中に開始", startDate: at("2025-10-25T10:00:00Z"), dueDate: at("2025-10-25T00:00:00Z"), allDay: true, wantError: nil},
+		{name: "終日の期日の翌日に開始", startDate: at("2025-10-26T10:00:00Z"), dueDate: at("2025-10-25T00:00:00Z"), allDay: true, wantError: domain.ErrStartAfterDue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := domain.NewTask(clock, 1, "テスト")
+			task.UpdateStartDate(clock, tt.startDate)
+			if tt.allDay {
+				task.UpdateAllDayDueDate(clock, tt.dueDate)
+			} else if tt.dueDate != nil {
+				task.UpdateDueDate(clock, tt.dueDate)
+			}
+			if err := task.ValidateSchedule(); err != tt.wantError {
+				t.Errorf("ValidateSchedule() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
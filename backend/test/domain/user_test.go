@@ -62,3 +62,26 @@ func TestNewUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_UpdateTimezone(t *testing.T) {
+	clock := &mockClock{}
+	user, _ := domain.NewUser(clock, "test@example.com", "テストユーザー")
+
+	if user.Timezone != domain.DefaultTimezone {
+		t.Errorf("Timezone = %v, want %v", user.Timezone, domain.DefaultTimezone)
+	}
+
+	if err := user.UpdateTimezone(clock, "Asia/Tokyo"); err != nil {
+		t.Fatalf("エラーが期待されていませんでした: %v", err)
+	}
+	if user.Location().String() != "Asia/Tokyo" {
+		t.Errorf("Location() = %v, want %v", user.Location(), "Asia/Tokyo")
+	}
+
+	if err := user.UpdateTimezone(clock, "Mars/Olympus"); err != domain.ErrInvalidTimezone {
+		t.Errorf("err = %v, want %v", err, domain.ErrInvalidTimezone)
+	}
+	if user.Timezone != "Asia/Tokyo" {
+		t.Errorf("不正なタイムゾーンで値が変更されました: %v", user.Timezone)
+	}
+}
@@ -0,0 +1,32 @@
+package domain_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+)
+
+func TestNewAssigneeWorkload(t *testing.T) {
+	workload := domain.NewAssigneeWorkload(2, "山田", "yamada@example.com")
+
+	if workload.UserID != 2 || workload.Total != 0 || workload.Overdue != 0 {
+		t.Errorf("workload = %+v, want UserID 2 の空の集計", workload)
+	}
+	for priority := 0; priority <= 5; priority++ {
+		if count, ok := workload.ByPriority[priority]; !ok || count != 0 {
+			t.Errorf("ByPriority[%d] = %d, %v, want 0, true", priority, count, ok)
+		}
+	}
+}
+
+func TestOpenTaskStatuses(t *testing.T) {
+	statuses := domain.OpenTaskStatuses()
+
+	if slices.Contains(statuses, domain.TaskStatusDONE) {
+		t.Errorf("OpenTaskStatuses() = %v, DONEを含んでいます", statuses)
+	}
+	if !slices.Contains(statuses, domain.TaskStatusTODO) || !slices.Contains(statuses, domain.TaskStatusIN_PROGRESS) {
+		t.Errorf("OpenTaskStatuses() = %v, want [TODO IN_PROGRESS]", statuses)
+	}
+}
@@ -0,0 +1,57 @@
+package mail_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mail"
+)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func TestOutboxSender_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	clock := fixedClock{now: time.Date(2025, 10, 22, 10, 0, 0, 0, time.UTC)}
+	sender := mail.NewOutboxSender(dir, "no-reply@example.com", clock)
+
+	err := sender.Send(context.Background(), domain.Mail{
+		To:      "alice@example.com",
+		Subject: "パスワードの再設定",
+		Body:    "https://example.com/reset-password?token=abc\n",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "20251022T100000-*.eml"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("outbox files = %v, err = %v, want 1 file", files, err)
+	}
+	content, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"From: no-reply@example.com\r\n",
+		"To: alice@example.com\r\n",
+		"Subject: =?utf-8?q?",
+		"Content-Type: text/plain; charset=UTF-8\r\n",
+		"\r\n\r\nhttps://example.com/reset-password?token=abc\n",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("message = %q, want to contain %q", content, want)
+		}
+	}
+}
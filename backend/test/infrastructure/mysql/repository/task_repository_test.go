@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/mysql/repository"
+)
+
+// fakeExecutorはUPDATEの影響行数と、タスクの存在確認の結果を返す
+type fakeExecutor struct {
+	affected   int64
+	taskExists bool
+
+	execQuery string
+	execArgs  []any
+
+	query     string
+	queryArgs []any
+}
+
+func (e *fakeExecutor) ExecContext(ctx context.Context, query string, args ...any) (domain.Result, error) {
+	e.execQuery = query
+	e.execArgs = args
+	return fakeResult{affected: e.affected}, nil
+}
+
+// QueryContextは発行されたクエリを記録してエラーを返す
+func (e *fakeExecutor) QueryContext(ctx context.Context, query string, args ...any) (domain.Rows, error) {
+	e.query = query
+	e.queryArgs = args
+	return nil, errors.New("query is not supported")
+}
+
+func (e *fakeExecutor) QueryRowContext(ctx context.Context, query string, args ...any) domain.Row {
+	return fakeRow{exists: e.taskExists}
+}
+
+type fakeResult struct {
+	affected int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) {
+	return 0, nil
+}
+
+func (r fakeResult) RowsAffected() (int64, error) {
+	return r.affected, nil
+}
+
+type fakeRow struct {
+	exists bool
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if !r.exists {
+		return sql.ErrNoRows
+	}
+	*dest[0].(*int) = 1
+	return nil
+}
+
+func TestTaskRepository_UpdateVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		affected    int64
+		taskExists  bool
+		wantErr     error
+		wantVersion int64
+	}{
+		{name: "バージョンが一致すれば更新してバージョンを進める", affected: 1, taskExists: true, wantVersion: 4},
+		{name: "他の更新が先に行われた場合はバージョンの不一致", affected: 0, taskExists: true, wantErr: domain.ErrTaskVersionMismatch, wantVersion: 3},
+		{name: "タスクが削除されている場合は見つからない", affected: 0, taskExists: false, wantErr: domain.ErrTaskNotFound, wantVersion: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &fakeExecutor{affected: tt.affected, taskExists: tt.taskExists}
+			task := &domain.Task{ID: 7, OwnerID: 1, Title: "task", Status: domain.TaskStatusTODO, Version: 3}
+
+			err := repository.NewTaskRepository().Update(context.Background(), ex, task)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+			if task.Version != tt.wantVersion {
+				t.Errorf("Version = %d, want %d", task.Version, tt.wantVersion)
+			}
+
+			// バージョンの照合は読み込みではなくUPDATEのWHERE句で行う
+			if !strings.Contains(ex.execQuery, "WHERE id = ? AND version = ?") {
+				t.Errorf("query does not check version in WHERE: %s", ex.execQuery)
+			}
+			if got := ex.execArgs[len(ex.execArgs)-1]; got != int64(3) {
+				t.Errorf("version arg = %v, want 3", got)
+			}
+		})
+	}
+}
+
+func TestTaskRepository_DeleteVersion(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		affected   int64
+		taskExists bool
+		wantErr    error
+	}{
+		{name: "バージョンが一致すれば削除する", affected: 1, taskExists: true},
+		{name: "他の更新が先に行われた場合はバージョンの不一致", affected: 0, taskExists: true, wantErr: domain.ErrTaskVersionMismatch},
+		{name: "タスクが削除されている場合は見つからない", affected: 0, taskExists: false, wantErr: domain.ErrTaskNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &fakeExecutor{affected: tt.affected, taskExists: tt.taskExists}
+
+			err := repository.NewTaskRepository().Delete(context.Background(), ex, 7, 3, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(ex.execQuery, "WHERE id = ? AND version = ?") {
+				t.Errorf("query does not check version in WHERE: %s", ex.execQuery)
+			}
+			if got := ex.execArgs[len(ex.execArgs)-1]; got != int64(3) {
+				t.Errorf("version arg = %v, want 3", got)
+			}
+		})
+	}
+}
+
+func TestTaskRepository_ListByUserIDVisibility(t *testing.T) {
+	// エクスポートや一覧は、オーナー・直接アサイン・グループアサイン・共有のいずれかで閲覧できるタスクに絞り込む
+	ex := &fakeExecutor{}
+	filter := domain.TaskFilter{SortKey: domain.TaskSortCreatedAt, SortDirection: domain.SortDesc}
+
+	_, _ = repository.NewTaskRepository().ListByUserID(context.Background(), ex, 42, filter, nil, 100)
+
+	for _, want := range []string{
+		"tasks.owner_id = ?",
+		"task_assignees.user_id = ?",
+		"group_members.user_id = ?",
+		"task_shares.user_id = ?",
+		"deleted_at IS NULL",
+	} {
+		if !strings.Contains(ex.query, want) {
+			t.Errorf("query does not contain %q: %s", want, ex.query)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		if got := ex.queryArgs[i]; got != int64(42) {
+			t.Errorf("args[%d] = %v, want user id 42", i, got)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/infrastructure/search"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestMemoryTaskSearchIndex_Search(t *testing.T) {
+	ctx := context.Background()
+
+	tasks := []*domain.Task{
+		{ID: 1, OwnerID: 1, Title: "ログイン画面のバグ修正", Description: strPtr("Safariでlogin後に白画面になる")},
+		{ID: 2, OwnerID: 1, Title: "週次レポート", Description: strPtr("ログイン数の集計を含める")},
+		{ID: 3, OwnerID: 2, Title: "ログイン監査", Description: nil},
+		{ID: 4, OwnerID: 1, Title: "Login API rate limit", Description: strPtr("login attempts per minute")},
+	}
+
+	// ユーザー1はタスク1,2,4のみ閲覧可能
+	visible := func(ctx context.Context, ex domain.Executor, userID, taskID int64) (bool, error) {
+		for _, task := range tasks {
+			if task.ID == taskID {
+				return task.OwnerID == userID, nil
+			}
+		}
+		return false, nil
+	}
+
+	index := search.NewMemoryTaskSearchIndex(visible)
+	for _, task := range tasks {
+		if err := index.Index(ctx, nil, task); err != nil {
+			t.Fatalf("Indexに失敗しました: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []int64
+	}{
+		{name: "タイトルでの一致が上位になる", query: "ログイン", want: []int64{1, 2}},
+		{name: "閲覧できないタスクは含まれない", query: "監査", want: nil},
+		{name: "大文字小文字を区別しない", query: "LOGIN", want: []int64{4, 1}},
+		{name: "すべての語を含むタスクのみ", query: "login safari", want: []int64{1}},
+		{name: "一致なし", query: "デプロイ", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := index.Search(ctx, nil, 1, tt.query, 10)
+			if err != nil {
+				t.Fatalf("Searchに失敗しました: %v", err)
+			}
+			if len(hits) != len(tt.want) {
+				t.Fatalf("件数 = %d, want %d (%v)", len(hits), len(tt.want), hits)
+			}
+			for i, hit := range hits {
+				if hit.TaskID != tt.want[i] {
+					t.Errorf("hits[%d].TaskID = %d, want %d", i, hit.TaskID, tt.want[i])
+				}
+			}
+		})
+	}
+
+	t.Run("更新と削除が反映される", func(t *testing.T) {
+		tasks[1].Title = "デプロイ手順"
+		tasks[1].Description = nil
+		if err := index.Index(ctx, nil, tasks[1]); err != nil {
+			t.Fatalf("Indexに失敗しました: %v", err)
+		}
+		if err := index.Remove(ctx, nil, 1); err != nil {
+			t.Fatalf("Removeに失敗しました: %v", err)
+		}
+
+		hits, _ := index.Search(ctx, nil, 1, "ログイン", 10)
+		if len(hits) != 0 {
+			t.Errorf("削除・更新後も一致しました: %v", hits)
+		}
+		hits, _ = index.Search(ctx, nil, 1, "デプロイ", 10)
+		if len(hits) != 1 || hits[0].TaskID != 2 {
+			t.Errorf("更新後のタイトルで一致しません: %v", hits)
+		}
+	})
+}
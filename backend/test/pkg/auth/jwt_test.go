@@ -19,8 +19,9 @@ func TestJWTService_GenerateToken(t *testing.T) {
 
 	userID := int64(123)
 	tokenVersion := 1
+	sessionID := int64(42)
 
-	token, err := service.GenerateToken(userID, tokenVersion)
+	token, err := service.GenerateToken(userID, tokenVersion, sessionID)
 	if err != nil {
 		t.Fatalf("トークン生成に失敗しました: %v", err)
 	}
@@ -42,8 +43,9 @@ func TestJWTService_ParseToken(t *testing.T) {
 
 	userID := int64(123)
 	tokenVersion := 1
+	sessionID := int64(42)
 
-	token, _ := service.GenerateToken(userID, tokenVersion)
+	token, _ := service.GenerateToken(userID, tokenVersion, sessionID)
 
 	t.Run("有効なトークン", func(t *testing.T) {
 		claims, err := service.ParseToken(token)
@@ -58,6 +60,10 @@ func TestJWTService_ParseToken(t *testing.T) {
 		if claims.TokenVersion != tokenVersion {
 			t.Errorf("TokenVersion = %v, want %v", claims.TokenVersion, tokenVersion)
 		}
+
+		if claims.SessionID != sessionID {
+			t.Errorf("SessionID = %v, want %v", claims.SessionID, sessionID)
+		}
 	})
 
 	t.Run("無効なトークン", func(t *testing.T) {
@@ -71,7 +77,7 @@ func TestJWTService_ParseToken(t *testing.T) {
 		otherService := auth.NewJWTService("different-secret", issuer, expiration, func() time.Time {
 			return now
 		})
-		otherToken, _ := otherService.GenerateToken(userID, tokenVersion)
+		otherToken, _ := otherService.GenerateToken(userID, tokenVersion, sessionID)
 
 		_, err := service.ParseToken(otherToken)
 		if err == nil {
@@ -0,0 +1,56 @@
+package csvutil_test
+
+import (
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/csvutil"
+)
+
+func TestEscapeFormula(t *testing.T) {
+	tests := []struct {
+		name string
+		cell string
+		want string
+	}{
+		{name: "等号", cell: "=HYPERLINK(\"http://evil\")", want: "'=HYPERLINK(\"http://evil\")"},
+		{name: "プラス", cell: "+1+1", want: "'+1+1"},
+		{name: "マイナス", cell: "-2+3", want: "'-2+3"},
+		{name: "アットマーク", cell: "@SUM(A1)", want: "'@SUM(A1)"},
+		{name: "タブ", cell: "\t=1", want: "'\t=1"},
+		{name: "CR", cell: "\r=1", want: "'\r=1"},
+		{name: "通常の文字列", cell: "資料作成", want: "資料作成"},
+		{name: "途中の等号", cell: "a=b", want: "a=b"},
+		{name: "空文字", cell: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := csvutil.EscapeFormula(tt.cell)
+			if got != tt.want {
+				t.Errorf("EscapeFormula(%q) = %q, want %q", tt.cell, got, tt.want)
+			}
+			if back := csvutil.UnescapeFormula(got); back != tt.cell {
+				t.Errorf("UnescapeFormula(%q) = %q, want %q", got, back, tt.cell)
+			}
+		})
+	}
+}
+
+func TestUnescapeFormula(t *testing.T) {
+	tests := []struct {
+		name string
+		cell string
+		want string
+	}{
+		{name: "数式でない値の先頭の「'」は残す", cell: "'quoted'", want: "'quoted'"},
+		{name: "「'」のみ", cell: "'", want: "'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := csvutil.UnescapeFormula(tt.cell); got != tt.want {
+				t.Errorf("UnescapeFormula(%q) = %q, want %q", tt.cell, got, tt.want)
+			}
+		})
+	}
+}
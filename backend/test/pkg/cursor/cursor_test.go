@@ -0,0 +1,57 @@
+package cursor_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
+)
+
+type payload struct {
+	Key string `json:"k"`
+	ID  int64  `json:"id"`
+}
+
+func TestSigner(t *testing.T) {
+	signer := cursor.NewSigner("secret")
+
+	token, err := signer.Encode(payload{Key: "2025-10-19T00:00:00Z", ID: 42})
+	if err != nil {
+		t.Fatalf("Encodeに失敗しました: %v", err)
+	}
+
+	t.Run("エンコードした値を復元できる", func(t *testing.T) {
+		var got payload
+		if err := signer.Decode(token, &got); err != nil {
+			t.Fatalf("Decodeに失敗しました: %v", err)
+		}
+		if got.Key != "2025-10-19T00:00:00Z" || got.ID != 42 {
+			t.Errorf("Decode() = %+v", got)
+		}
+	})
+
+	body, sig, _ := strings.Cut(token, ".")
+	other, _ := cursor.NewSigner("other").Encode(payload{ID: 42})
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "空文字", token: ""},
+		{name: "区切りなし", token: body},
+		{name: "本文の改ざん", token: "x" + body[1:] + "." + sig},
+		{name: "署名の改ざん", token: body + "." + strings.Repeat("A", len(sig))},
+		{name: "別の鍵で署名", token: other},
+		{name: "base64でない", token: "!!!.???"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"は不正なカーソル", func(t *testing.T) {
+			var got payload
+			if err := signer.Decode(tt.token, &got); !errors.Is(err, cursor.ErrInvalidCursor) {
+				t.Errorf("Decode() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
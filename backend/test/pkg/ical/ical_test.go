@@ -0,0 +1,102 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/pkg/ical"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	now := time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 10, 25, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	allDay := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	calendar := &ical.Calendar{
+		ProdID: "-//task_app_layerx//JA",
+		Name:   "タスク",
+		Now:    now,
+		Items: []ical.Item{
+			{UID: "task-1@example.com", Summary: "資料作成; 第1版, 下書き", Due: due, Status: ical.TodoStatusInProcess, Priority: 3},
+			{UID: "task-2@example.com", Summary: "締め日", Due: allDay, AllDay: true, Status: ical.TodoStatusNeedsAction},
+		},
+	}
+
+	var b strings.Builder
+	if err := calendar.Encode(&b); err != nil {
+		t.Fatalf("Encodeに失敗しました: %v", err)
+	}
+	out := b.String()
+
+	t.Run("VTODOとして出力される", func(t *testing.T) {
+		for _, want := range []string{
+			"BEGIN:VCALENDAR\r\n",
+			"BEGIN:VTODO\r\n",
+			"DUE:20251024T230000Z\r\n",
+			"DUE;VALUE=DATE:20251031\r\n",
+			"STATUS:IN-PROCESS\r\n",
+			"STATUS:NEEDS-ACTION\r\n",
+			"PRIORITY:3\r\n",
+			"DTSTAMP:20251019T120000Z\r\n",
+			"END:VCALENDAR\r\n",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("出力に%qが含まれていません", want)
+			}
+		}
+	})
+
+	t.Run("特殊文字がエスケープされる", func(t *testing.T) {
+		if !strings.Contains(out, `SUMMARY:資料作成\; 第1版\, 下書き`) {
+			t.Errorf("SUMMARYがエスケープされていません: %s", out)
+		}
+	})
+}
+
+func TestCalendar_EncodeEvent(t *testing.T) {
+	calendar := &ical.Calendar{
+		ProdID:    "-//task_app_layerx//JA",
+		Component: ical.ComponentEvent,
+		Items: []ical.Item{
+			{UID: "task-2@example.com", Summary: "締め日", Due: time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC), AllDay: true},
+		},
+	}
+
+	var b strings.Builder
+	if err := calendar.Encode(&b); err != nil {
+		t.Fatalf("Encodeに失敗しました: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{"BEGIN:VEVENT\r\n", "DTSTART;VALUE=DATE:20251031\r\n", "DTEND;VALUE=DATE:20251101\r\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("出力に%qが含まれていません", want)
+		}
+	}
+}
+
+func TestCalendar_EncodeFoldsLongLines(t *testing.T) {
+	calendar := &ical.Calendar{
+		ProdID: "-//task_app_layerx//JA",
+		Items: []ical.Item{
+			{UID: "task-3@example.com", Summary: strings.Repeat("長いタイトル", 20), Due: time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC), AllDay: true},
+		},
+	}
+
+	var b strings.Builder
+	if err := calendar.Encode(&b); err != nil {
+		t.Fatalf("Encodeに失敗しました: %v", err)
+	}
+
+	for _, line := range strings.Split(b.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("75オクテットを超える行があります: %d", len(line))
+		}
+	}
+	// 折り返しを戻すと元のタイトルになる
+	unfolded := strings.ReplaceAll(b.String(), "\r\n ", "")
+	if !strings.Contains(unfolded, "SUMMARY:"+strings.Repeat("長いタイトル", 20)+"\r\n") {
+		t.Error("折り返しを戻したSUMMARYが元のタイトルと一致しません")
+	}
+}
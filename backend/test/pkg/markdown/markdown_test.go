@@ -0,0 +1,100 @@
+package markdown_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/markdown"
+)
+
+func TestRender(t *testing.T) {
+	// タスク1と2のみ閲覧可能
+	linkable := func(id int64) bool { return id == 1 || id == 2 }
+
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "CommonMarkの基本的な記法",
+			source:   "# 見出し\n\n- **太字** と `code`\n- [リンク](https://example.com)",
+			contains: []string{"<h1>見出し</h1>", "<strong>太字</strong>", "<code>code</code>", `<a href="https://example.com" rel="nofollow">リンク</a>`},
+		},
+		{
+			name:     "生のHTMLのスクリプトは除去",
+			source:   "before <script>alert(1)</script> after\n\n<img src=x onerror=alert(1)>",
+			contains: []string{"before", "after"},
+			excludes: []string{"<script", "<img", "onerror"},
+		},
+		{
+			name:     "javascript:のURLは除去",
+			source:   "[click](javascript:alert(1)) <javascript:alert(1)>",
+			contains: []string{"click"},
+			excludes: []string{"<a", "href"},
+		},
+		{
+			name:     "閲覧可能なタスクへの参照はリンク",
+			source:   "#1 と #2 に関連",
+			contains: []string{`<a href="/tasks/1" rel="nofollow">#1</a>`, `<a href="/tasks/2" rel="nofollow">#2</a>`},
+		},
+		{
+			name:     "閲覧できないタスクへの参照はテキストのまま",
+			source:   "#3 を参照",
+			contains: []string{"#3 を参照"},
+			excludes: []string{"/tasks/3"},
+		},
+		{
+			name:     "コードやリンクのテキスト中の参照はリンクにしない",
+			source:   "`#1` と [see #2](https://example.com/#2)\n\n    #1",
+			excludes: []string{"/tasks/"},
+		},
+		{
+			name:     "英数字に隣接する#や文字参照は参照としない",
+			source:   "abc#1 #1abc &#49;",
+			excludes: []string{"/tasks/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := markdown.Render(tt.source, linkable)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("Render() = %q, want to contain %q", got, want)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("Render() = %q, want not to contain %q", got, unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskReferences(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []int64
+	}{
+		{name: "出現順に重複なし", source: "#3 は #1 と #3 に依存", want: []int64{3, 1}},
+		{name: "コードとリンクのテキストは除外", source: "`#1` [#2](https://example.com) #4", want: []int64{4}},
+		{name: "見出しの#は参照ではない", source: "# 5\n\n#6", want: []int64{6}},
+		{name: "参照なし", source: "説明のみ", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markdown.TaskReferences(tt.source); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TaskReferences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package quickadd_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/pkg/quickadd"
+)
+
+func TestParse(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// 2025年10月22日（水）10時（東京）
+	now := time.Date(2025, 10, 22, 10, 0, 0, 0, tokyo)
+	priority := func(p int) *int { return &p }
+	at := func(month time.Month, day, hour, minute int) *time.Time {
+		t := time.Date(2025, month, day, hour, minute, 0, 0, tokyo)
+		return &t
+	}
+	date := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  quickadd.Result
+	}{
+		{
+			name:  "英語の日時・優先度・メンション・ラベル",
+			input: "Fix login bug tomorrow 5pm !4 @alice #backend",
+			want: quickadd.Result{
+				Title:    "Fix login bug",
+				DueDate:  at(10, 23, 17, 0),
+				Priority: priority(4),
+				Mentions: []string{"alice"},
+				Labels:   []string{"backend"},
+			},
+		},
+		{
+			name:  "日付のみは終日の期日",
+			input: "資料作成 明日",
+			want:  quickadd.Result{Title: "資料作成", DueDate: date(2025, 10, 23), DueAllDay: true},
+		},
+		{
+			name:  "来週金曜",
+			input: "来週金曜 レビュー",
+			want:  quickadd.Result{Title: "レビュー", DueDate: date(2025, 10, 31), DueAllDay: true},
+		},
+		{
+			name:  "曜日のみは今日を含む直近の曜日",
+			input: "定例 水曜",
+			want:  quickadd.Result{Title: "定例", DueDate: date(2025, 10, 22), DueAllDay: true},
+		},
+		{
+			name:  "next friday",
+			input: "Ship release next friday at 9:30",
+			want:  quickadd.Result{Title: "Ship release", DueDate: at(10, 31, 9, 30)},
+		},
+		{
+			name:  "日本語の日付と午後の時刻",
+			input: "明後日午後3時半に打ち合わせ",
+			want:  quickadd.Result{Title: "打ち合わせ", DueDate: at(10, 24, 15, 30)},
+		},
+		{
+			name:  "過ぎた月日は翌年",
+			input: "年賀状 1月5日",
+			want:  quickadd.Result{Title: "年賀状", DueDate: date(2026, 1, 5), DueAllDay: true},
+		},
+		{
+			name:  "ISO形式の日付と24時間表記",
+			input: "Deploy 2025-11-03 17:00",
+			want:  quickadd.Result{Title: "Deploy", DueDate: at(11, 3, 17, 0)},
+		},
+		{
+			name:  "過ぎた時刻のみは明日",
+			input: "Standup 9am",
+			want:  quickadd.Result{Title: "Standup", DueDate: at(10, 23, 9, 0)},
+		},
+		{
+			name:  "存在しない日付はタイトルに残す",
+			input: "Plan 2/30",
+			want:  quickadd.Result{Title: "Plan 2/30"},
+		},
+		{
+			name:  "範囲外の優先度とメールアドレスはタイトルに残す",
+			input: "Reply to bob@example.com !9",
+			want:  quickadd.Result{Title: "Reply to bob@example.com !9"},
+		},
+		{
+			name:  "複数のメンション・ラベルは重複を除く",
+			input: "Pair @Alice @bob@example.com @alice #FE #fe",
+			want:  quickadd.Result{Title: "Pair", Mentions: []string{"alice", "bob@example.com"}, Labels: []string{"fe"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quickadd.Parse(tt.input, now)
+
+			if got.Title != tt.want.Title {
+				t.Errorf("Title = %q, want %q", got.Title, tt.want.Title)
+			}
+			if (got.DueDate == nil) != (tt.want.DueDate == nil) || (got.DueDate != nil && !got.DueDate.Equal(*tt.want.DueDate)) {
+				t.Errorf("DueDate = %v, want %v", got.DueDate, tt.want.DueDate)
+			}
+			if got.DueAllDay != tt.want.DueAllDay {
+				t.Errorf("DueAllDay = %v, want %v", got.DueAllDay, tt.want.DueAllDay)
+			}
+			if !reflect.DeepEqual(got.Priority, tt.want.Priority) {
+				t.Errorf("Priority = %v, want %v", got.Priority, tt.want.Priority)
+			}
+			if !reflect.DeepEqual(got.Mentions, tt.want.Mentions) {
+				t.Errorf("Mentions = %v, want %v", got.Mentions, tt.want.Mentions)
+			}
+			if !reflect.DeepEqual(got.Labels, tt.want.Labels) {
+				t.Errorf("Labels = %v, want %v", got.Labels, tt.want.Labels)
+			}
+		})
+	}
+}
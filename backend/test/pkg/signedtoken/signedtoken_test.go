@@ -0,0 +1,59 @@
+package signedtoken_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/signedtoken"
+)
+
+type payload struct {
+	Key string `json:"k"`
+	ID  int64  `json:"id"`
+}
+
+func TestSigner(t *testing.T) {
+	signer := signedtoken.NewSigner("secret", "test")
+
+	token, err := signer.Encode(payload{Key: "value", ID: 42})
+	if err != nil {
+		t.Fatalf("Encodeに失敗しました: %v", err)
+	}
+
+	t.Run("エンコードした値を復元できる", func(t *testing.T) {
+		var got payload
+		if err := signer.Decode(token, &got); err != nil {
+			t.Fatalf("Decodeに失敗しました: %v", err)
+		}
+		if got.Key != "value" || got.ID != 42 {
+			t.Errorf("Decode() = %+v", got)
+		}
+	})
+
+	body, sig, _ := strings.Cut(token, ".")
+	otherSecret, _ := signedtoken.NewSigner("other", "test").Encode(payload{Key: "value", ID: 42})
+	otherPurpose, _ := signedtoken.NewSigner("secret", "other").Encode(payload{Key: "value", ID: 42})
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "空文字", token: ""},
+		{name: "区切りなし", token: body},
+		{name: "本文の改ざん", token: "x" + body[1:] + "." + sig},
+		{name: "署名の改ざん", token: body + "." + strings.Repeat("A", len(sig))},
+		{name: "別の鍵で署名", token: otherSecret},
+		{name: "同じ鍵・別の用途で署名", token: otherPurpose},
+		{name: "base64でない", token: "!!!.???"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"は不正なトークン", func(t *testing.T) {
+			var got payload
+			if err := signer.Decode(tt.token, &got); !errors.Is(err, signedtoken.ErrInvalidToken) {
+				t.Errorf("Decode() error = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package textsearch_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/textsearch"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "英単語は小文字の単語単位", text: "Fix Login-Bug v2", want: []string{"fix", "login", "bug", "v2"}},
+		{name: "日本語は2文字ずつ", text: "資料作成", want: []string{"資料", "料作", "作成"}},
+		{name: "1文字の日本語はそのまま", text: "本", want: []string{"本"}},
+		{name: "英語と日本語の混在", text: "API設計書", want: []string{"api", "設計", "計書"}},
+		{name: "空文字", text: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := textsearch.Tokenize(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := textsearch.Terms("  Login  バグ login ")
+	want := []string{"login", "バグ"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Terms() = %v, want %v", got, want)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     string
+	}{
+		{
+			name:  "一致箇所を大文字小文字を区別せずmarkで囲む",
+			text:  "Fix login bug",
+			terms: []string{"login"},
+			want:  "Fix <mark>login</mark> bug",
+		},
+		{
+			name:  "HTMLはエスケープされる",
+			text:  "<b>資料</b>作成",
+			terms: []string{"資料"},
+			want:  "&lt;b&gt;<mark>資料</mark>&lt;/b&gt;作成",
+		},
+		{
+			name:     "長いテキストは一致箇所の付近を切り出す",
+			text:     "0123456789abcdefghijklmnopqrstuvwxyz",
+			terms:    []string{"klm"},
+			maxRunes: 8,
+			want:     "…ij<mark>klm</mark>nop…",
+		},
+		{
+			name:     "一致しない場合は先頭から切り出す",
+			text:     "abcdefghij",
+			terms:    []string{"xyz"},
+			maxRunes: 4,
+			want:     "abcd…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := textsearch.Highlight(tt.text, tt.terms, tt.maxRunes)
+			if got != tt.want {
+				t.Errorf("Highlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package useragent_test
+
+import (
+	"testing"
+
+	"github.com/ryusuke/task_app_layerx/pkg/useragent"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		want      string
+	}{
+		{
+			name:      "macOSのChrome",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36",
+			want:      "Chrome on macOS",
+		},
+		{
+			name:      "WindowsのEdge",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36 Edg/129.0.0.0",
+			want:      "Edge on Windows",
+		},
+		{
+			name:      "iPhoneのSafari",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.6 Mobile/15E148 Safari/604.1",
+			want:      "Safari on iOS",
+		},
+		{
+			name:      "AndroidのFirefox",
+			userAgent: "Mozilla/5.0 (Android 14; Mobile; rv:131.0) Gecko/131.0 Firefox/131.0",
+			want:      "Firefox on Android",
+		},
+		{
+			name:      "OSのないクライアント",
+			userAgent: "curl/8.7.1",
+			want:      "curl",
+		},
+		{
+			name:      "空のUser-Agent",
+			userAgent: "",
+			want:      "Unknown device",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := useragent.Describe(tt.userAgent); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
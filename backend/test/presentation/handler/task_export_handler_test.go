@@ -0,0 +1,210 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/handler"
+)
+
+// ListByUserIDはオーナーまたは共有先のユーザーが閲覧できるタスクを、ID（作成順）の降順でafterの続きから返す
+func (r *mockTaskRepository) ListByUserID(ctx context.Context, ex domain.Executor, userID int64, filter domain.TaskFilter, after *domain.TaskCursor, limit int) ([]*domain.Task, error) {
+	ids := make([]int64, 0, len(r.tasks))
+	for id := range r.tasks {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	slices.Reverse(ids)
+
+	var tasks []*domain.Task
+	for _, id := range ids {
+		if after != nil && id >= after.ID {
+			continue
+		}
+		task := *r.tasks[id]
+		if task.OwnerID != userID && !slices.Contains(r.sharedWith[id], userID) {
+			continue
+		}
+		tasks = append(tasks, &task)
+		if len(tasks) == limit {
+			break
+		}
+	}
+	return tasks, nil
+}
+
+// newExportTaskHandlerはAsia/Tokyoのユーザー1が閲覧するエクスポート用のTaskHandlerを作成
+// タスク1はユーザー1のタスク、タスク2はユーザー9からユーザー1に共有されたタスク、タスク3はユーザー9だけのタスク
+func newExportTaskHandler() *handler.TaskHandler {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	description := "説明\n2行目"
+
+	taskRepo := &mockTaskRepository{
+		tasks: map[int64]*domain.Task{
+			1: {ID: 1, OwnerID: ownerID, Title: `=HYPERLINK("x")`, Description: &description, Status: domain.TaskStatusTODO, Priority: 3, DueDate: &dueDate, DueAllDay: true, CreatedAt: createdAt, UpdatedAt: createdAt},
+			2: {ID: 2, OwnerID: 9, Title: "共有|タスク", Status: domain.TaskStatusIN_PROGRESS, DueDate: &dueDate, CreatedAt: createdAt, UpdatedAt: createdAt},
+			3: {ID: 3, OwnerID: 9, Title: "他人のタスク", Status: domain.TaskStatusTODO, CreatedAt: createdAt, UpdatedAt: createdAt},
+		},
+		sharedWith: map[int64][]int64{2: {ownerID}},
+	}
+	assigneeRepo := &mockTaskAssigneeRepository{
+		assignees: map[int64][]*domain.TaskAssignee{
+			1: {{TaskID: 1, UserID: 2}, {TaskID: 1, UserID: 3}},
+		},
+	}
+	userRepo := &mockUserRepository{
+		users: map[int64]*domain.User{
+			ownerID: {ID: ownerID, Timezone: "Asia/Tokyo"},
+			2:       {ID: 2, Name: "Alice"},
+			3:       {ID: 3, Name: "Bob | Jr."},
+		},
+	}
+	return newTaskHandlerWith(taskRepo, assigneeRepo, userRepo)
+}
+
+// serveExportはエクスポートをユーザー1として処理する
+func serveExport(h *handler.TaskHandler, format string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/export?format="+format, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("userID", int64(ownerID))
+	if err := h.ExportTasks(c); err != nil {
+		panic(err)
+	}
+	return rec
+}
+
+func TestTaskHandler_ExportTasks(t *testing.T) {
+	tests := []struct {
+		name            string
+		format          string
+		wantContentType string
+		wantFilename    string
+		wantBody        string
+	}{
+		{
+			name:            "CSVは数式として解釈されるセルをエスケープし、閲覧できないタスクを含めない",
+			format:          "csv",
+			wantContentType: "text/csv; charset=utf-8",
+			wantFilename:    "tasks.csv",
+			wantBody: "id,title,description,status,priority,start_date,due_date,assignees,created_at,updated_at\n" +
+				"2,共有|タスク,,IN_PROGRESS,0,,2025-01-10T09:00:00+09:00,,2025-01-01T09:00:00+09:00,2025-01-01T09:00:00+09:00\n" +
+				"1,\"'=HYPERLINK(\"\"x\"\")\",\"説明\n2行目\",TODO,3,,2025-01-10,\"Alice, Bob | Jr.\",2025-01-01T09:00:00+09:00,2025-01-01T09:00:00+09:00\n",
+		},
+		{
+			name:            "formatを省略した場合はCSV",
+			wantContentType: "text/csv; charset=utf-8",
+			wantFilename:    "tasks.csv",
+			wantBody: "id,title,description,status,priority,start_date,due_date,assignees,created_at,updated_at\n" +
+				"2,共有|タスク,,IN_PROGRESS,0,,2025-01-10T09:00:00+09:00,,2025-01-01T09:00:00+09:00,2025-01-01T09:00:00+09:00\n" +
+				"1,\"'=HYPERLINK(\"\"x\"\")\",\"説明\n2行目\",TODO,3,,2025-01-10,\"Alice, Bob | Jr.\",2025-01-01T09:00:00+09:00,2025-01-01T09:00:00+09:00\n",
+		},
+		{
+			name:            "Markdownは表を壊す文字をエスケープし、閲覧できないタスクを含めない",
+			format:          "md",
+			wantContentType: "text/markdown; charset=utf-8",
+			wantFilename:    "tasks.md",
+			wantBody: "| ID | タイトル | ステータス | 優先度 | 開始日 | 期日 | 担当者 |\n" +
+				"| --- | --- | --- | --- | --- | --- | --- |\n" +
+				"| 2 | 共有\\|タスク | IN_PROGRESS | 0 |  | 2025-01-10T09:00:00+09:00 |  |\n" +
+				"| 1 | =HYPERLINK(\"x\") | TODO | 3 |  | 2025-01-10 | Alice, Bob \\| Jr. |\n",
+		},
+		{
+			name:            "JSONは値をエスケープせずに配列で書き出し、閲覧できないタスクを含めない",
+			format:          "json",
+			wantContentType: echo.MIMEApplicationJSONCharsetUTF8,
+			wantFilename:    "tasks.json",
+			wantBody: `[{"id":2,"title":"共有|タスク","description":null,"status":"IN_PROGRESS","priority":0,"startDate":null,"dueDate":"2025-01-10T09:00:00+09:00","assignees":[],"createdAt":"2025-01-01T09:00:00+09:00","updatedAt":"2025-01-01T09:00:00+09:00"},` +
+				`{"id":1,"title":"=HYPERLINK(\"x\")","description":"説明\n2行目","status":"TODO","priority":3,"startDate":null,"dueDate":"2025-01-10","assignees":["Alice","Bob | Jr."],"createdAt":"2025-01-01T09:00:00+09:00","updatedAt":"2025-01-01T09:00:00+09:00"}]` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveExport(newExportTaskHandler(), tt.format)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != tt.wantContentType {
+				t.Errorf("Content-Type = %s, want %s", got, tt.wantContentType)
+			}
+			if got := rec.Header().Get(echo.HeaderContentDisposition); !strings.Contains(got, tt.wantFilename) {
+				t.Errorf("Content-Disposition = %s, want %s", got, tt.wantFilename)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body =\n%s\nwant\n%s", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestTaskHandler_ExportTasks_Empty(t *testing.T) {
+	tests := []struct {
+		format   string
+		wantBody string
+	}{
+		{format: "csv", wantBody: "id,title,description,status,priority,start_date,due_date,assignees,created_at,updated_at\n"},
+		{format: "md", wantBody: "| ID | タイトル | ステータス | 優先度 | 開始日 | 期日 | 担当者 |\n| --- | --- | --- | --- | --- | --- | --- |\n"},
+		{format: "json", wantBody: "[]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"はタスクが0件でもヘッダーのみのファイルを返す", func(t *testing.T) {
+			h := newTaskHandlerWith(&mockTaskRepository{}, &mockTaskAssigneeRepository{}, &mockUserRepository{})
+
+			rec := serveExport(h, tt.format)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestTaskHandler_ExportTasks_Pages(t *testing.T) {
+	// 1ページ（100件）を超えるタスクもページをまたいで重複・欠落なく書き出す
+	taskRepo := &mockTaskRepository{tasks: map[int64]*domain.Task{}}
+	for id := int64(1); id <= 250; id++ {
+		taskRepo.tasks[id] = &domain.Task{ID: id, OwnerID: ownerID, Title: "task", Status: domain.TaskStatusTODO}
+	}
+	h := newTaskHandlerWith(taskRepo, &mockTaskAssigneeRepository{}, &mockUserRepository{})
+
+	rec := serveExport(h, "json")
+
+	var tasks []handler.ExportTask
+	if err := json.Unmarshal(rec.Body.Bytes(), &tasks); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if len(tasks) != 250 {
+		t.Fatalf("tasks = %d, want 250", len(tasks))
+	}
+	for i, task := range tasks {
+		if want := int64(250 - i); task.ID != want {
+			t.Fatalf("tasks[%d].ID = %d, want %d", i, task.ID, want)
+		}
+	}
+}
+
+func TestTaskHandler_ExportTasks_InvalidFormat(t *testing.T) {
+	rec := serveExport(newExportTaskHandler(), "xlsx")
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "VALIDATION_ERROR") {
+		t.Errorf("body = %s, want VALIDATION_ERROR", rec.Body.String())
+	}
+}
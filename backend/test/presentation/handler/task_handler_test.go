@@ -0,0 +1,320 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/handler"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+	"github.com/ryusuke/task_app_layerx/pkg/cursor"
+)
+
+const ownerID = 1
+
+type mockClock struct {
+	now time.Time
+}
+
+func (c *mockClock) Now() time.Time {
+	return c.now
+}
+
+type mockTxManager struct{}
+
+func (m *mockTxManager) Do(ctx context.Context, fn func(context.Context, domain.Executor) error) error {
+	return fn(ctx, nil)
+}
+
+func (m *mockTxManager) AsExecutor() domain.Executor {
+	return nil
+}
+
+// mockTaskRepositoryはリポジトリと同じく、保存時にバージョンを照合して一致しなければErrTaskVersionMismatchを返す
+type mockTaskRepository struct {
+	domain.TaskRepository
+	tasks map[int64]*domain.Task
+	// concurrentWriteは読み込みから保存までの間に他の更新が保存されたことを再現する
+	concurrentWrite bool
+	// sharedWithはタスクIDごとの共有先ユーザー（オーナー以外で閲覧できるユーザー）
+	sharedWith map[int64][]int64
+}
+
+func (r *mockTaskRepository) FindByID(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	task, ok := r.tasks[taskID]
+	if !ok {
+		return nil, domain.ErrTaskNotFound
+	}
+	copied := *task
+	return &copied, nil
+}
+
+func (r *mockTaskRepository) FindByIDForUpdate(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	return r.FindByID(ctx, ex, taskID)
+}
+
+func (r *mockTaskRepository) FindVisibleIDs(ctx context.Context, ex domain.Executor, userID int64, taskIDs []int64) ([]int64, error) {
+	return nil, nil
+}
+
+func (r *mockTaskRepository) Update(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	if err := r.checkVersion(task.ID, task.Version); err != nil {
+		return err
+	}
+	task.Version++
+	copied := *task
+	r.tasks[task.ID] = &copied
+	return nil
+}
+
+func (r *mockTaskRepository) Delete(ctx context.Context, ex domain.Executor, taskID, version int64, now time.Time) error {
+	if err := r.checkVersion(taskID, version); err != nil {
+		return err
+	}
+	delete(r.tasks, taskID)
+	return nil
+}
+
+func (r *mockTaskRepository) checkVersion(taskID, version int64) error {
+	if r.concurrentWrite {
+		r.tasks[taskID].Version++
+	}
+	if r.tasks[taskID].Version != version {
+		return domain.ErrTaskVersionMismatch
+	}
+	return nil
+}
+
+type mockTaskAssigneeRepository struct {
+	domain.TaskAssigneeRepository
+	assignees map[int64][]*domain.TaskAssignee
+}
+
+func (r *mockTaskAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskAssignee, error) {
+	return r.assignees[taskID], nil
+}
+
+func (r *mockTaskAssigneeRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+type mockTaskGroupAssigneeRepository struct {
+	domain.TaskGroupAssigneeRepository
+}
+
+func (r *mockTaskGroupAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskGroupAssignee, error) {
+	return nil, nil
+}
+
+func (r *mockTaskGroupAssigneeRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+type mockTaskShareRepository struct {
+	domain.TaskShareRepository
+}
+
+func (r *mockTaskShareRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+type mockTaskDependencyRepository struct {
+	domain.TaskDependencyRepository
+}
+
+func (r *mockTaskDependencyRepository) DeleteByTaskID(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+type mockSLAPolicyRepository struct{}
+
+func (r *mockSLAPolicyRepository) FindAll(ctx context.Context, ex domain.Executor) ([]*domain.SLAPolicy, error) {
+	return nil, nil
+}
+
+type mockSearchIndex struct {
+	domain.TaskSearchIndex
+}
+
+func (i *mockSearchIndex) Index(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	return nil
+}
+
+func (i *mockSearchIndex) Remove(ctx context.Context, ex domain.Executor, taskID int64) error {
+	return nil
+}
+
+type mockTaskRevisionRepository struct {
+	domain.TaskRevisionRepository
+}
+
+func (r *mockTaskRevisionRepository) Create(ctx context.Context, ex domain.Executor, revision *domain.TaskRevision) error {
+	return nil
+}
+
+// mockUserRepositoryはusersにないユーザーもIDだけを持つユーザーとして返す
+type mockUserRepository struct {
+	domain.UserRepository
+	users map[int64]*domain.User
+}
+
+func (r *mockUserRepository) FindByID(ctx context.Context, ex domain.Executor, id int64) (*domain.User, error) {
+	if user, ok := r.users[id]; ok {
+		return user, nil
+	}
+	return &domain.User{ID: id}, nil
+}
+
+// newTaskHandlerはバージョン3のタスク（ID 7）を持つTaskHandlerを作成
+func newTaskHandler(taskRepo *mockTaskRepository) *handler.TaskHandler {
+	taskRepo.tasks = map[int64]*domain.Task{
+		7: {ID: 7, OwnerID: ownerID, Title: "current", Status: domain.TaskStatusTODO, Version: 3},
+	}
+	return newTaskHandlerWith(taskRepo, &mockTaskAssigneeRepository{}, &mockUserRepository{})
+}
+
+func newTaskHandlerWith(taskRepo *mockTaskRepository, assigneeRepo *mockTaskAssigneeRepository, userRepo *mockUserRepository) *handler.TaskHandler {
+	uc := taskuc.NewTaskUseCase(
+		taskRepo,
+		assigneeRepo,
+		&mockTaskGroupAssigneeRepository{},
+		nil, nil,
+		&mockTaskShareRepository{},
+		&mockTaskDependencyRepository{},
+		&mockSLAPolicyRepository{},
+		&mockSearchIndex{},
+		&mockTaskRevisionRepository{},
+		nil,
+		userRepo,
+		&mockTxManager{},
+		&mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		domain.UnverifiedUserPolicyNone,
+	)
+	return handler.NewTaskHandler(uc, cursor.NewSigner("secret"))
+}
+
+// serveTaskはタスク7へのリクエストをオーナーとして処理する
+func serveTask(h echo.HandlerFunc, method, ifMatch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/tasks/7", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+	c.Set("userID", int64(ownerID))
+	if err := h(c); err != nil {
+		panic(err)
+	}
+	return rec
+}
+
+func TestTaskHandler_UpdateTask_IfMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		ifMatch         string
+		concurrentWrite bool
+		wantStatus      int
+		wantETag        string
+		wantCode        string
+	}{
+		{name: "ETagが一致すれば更新して新しいETagを返す", ifMatch: `"3"`, wantStatus: http.StatusOK, wantETag: `"4"`},
+		{name: "前後の空白は無視する", ifMatch: ` "3" `, wantStatus: http.StatusOK, wantETag: `"4"`},
+		{name: "*はバージョンを照合しない", ifMatch: "*", wantStatus: http.StatusOK, wantETag: `"4"`},
+		{name: "省略時はバージョンを照合しない", wantStatus: http.StatusOK, wantETag: `"4"`},
+		{name: "ETagが一致しなければ412と現在のタスクを返す", ifMatch: `"2"`, wantStatus: http.StatusPreconditionFailed, wantETag: `"3"`},
+		{name: "読み込み後に他の更新が保存された場合も412", ifMatch: `"3"`, concurrentWrite: true, wantStatus: http.StatusPreconditionFailed, wantETag: `"4"`},
+		{name: "If-Matchを指定していなければ他の更新と競合しても412にしない", concurrentWrite: true, wantStatus: http.StatusConflict, wantCode: "CONFLICT"},
+		{name: "弱いETagは受け付けない", ifMatch: `W/"3"`, wantStatus: http.StatusBadRequest, wantCode: "INVALID_IF_MATCH"},
+		{name: "引用符のないETagは受け付けない", ifMatch: "3", wantStatus: http.StatusBadRequest, wantCode: "INVALID_IF_MATCH"},
+		{name: "複数のETagは受け付けない", ifMatch: `"3", "4"`, wantStatus: http.StatusBadRequest, wantCode: "INVALID_IF_MATCH"},
+		{name: "数値でないETagは受け付けない", ifMatch: `"abc"`, wantStatus: http.StatusBadRequest, wantCode: "INVALID_IF_MATCH"},
+		{name: "0以下のバージョンは受け付けない", ifMatch: `"0"`, wantStatus: http.StatusBadRequest, wantCode: "INVALID_IF_MATCH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := &mockTaskRepository{concurrentWrite: tt.concurrentWrite}
+			h := newTaskHandler(taskRepo)
+
+			rec := serveTask(h.UpdateTask, http.MethodPatch, tt.ifMatch, `{"title":"updated"}`)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Errorf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			var body map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid json: %v", err)
+			}
+			if tt.wantCode != "" && body["code"] != tt.wantCode {
+				t.Errorf("code = %v, want %s", body["code"], tt.wantCode)
+			}
+
+			switch tt.wantStatus {
+			case http.StatusOK:
+				if body["title"] != "updated" {
+					t.Errorf("title = %v, want updated", body["title"])
+				}
+			case http.StatusPreconditionFailed:
+				// 412のボディは変更を適用していない現在のタスク
+				if body["title"] != "current" {
+					t.Errorf("title = %v, want current", body["title"])
+				}
+				if taskRepo.tasks[7].Title != "current" {
+					t.Errorf("stored title = %s, want current", taskRepo.tasks[7].Title)
+				}
+				if got := strconv.Quote(strconv.FormatFloat(body["version"].(float64), 'f', -1, 64)); got != tt.wantETag {
+					t.Errorf("version = %s, want %s", got, tt.wantETag)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskHandler_DeleteTask_IfMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		ifMatch         string
+		concurrentWrite bool
+		wantStatus      int
+	}{
+		{name: "ETagが一致すれば削除する", ifMatch: `"3"`, wantStatus: http.StatusNoContent},
+		{name: "省略時はバージョンを照合しない", wantStatus: http.StatusNoContent},
+		{name: "ETagが一致しなければ412と現在のタスクを返す", ifMatch: `"2"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "If-Matchを指定していなければ他の更新と競合しても412にしない", concurrentWrite: true, wantStatus: http.StatusConflict},
+		{name: "弱いETagは受け付けない", ifMatch: `W/"3"`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := &mockTaskRepository{concurrentWrite: tt.concurrentWrite}
+			h := newTaskHandler(taskRepo)
+
+			rec := serveTask(h.DeleteTask, http.MethodDelete, tt.ifMatch, "")
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			_, exists := taskRepo.tasks[7]
+			if exists != (tt.wantStatus != http.StatusNoContent) {
+				t.Errorf("task exists = %v after status %d", exists, rec.Code)
+			}
+			if tt.wantStatus == http.StatusPreconditionFailed && rec.Header().Get("ETag") != `"3"` {
+				t.Errorf("ETag = %q, want \"3\"", rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/handler"
+)
+
+// FindByEmailはどのメールアドレスのユーザーも存在しないものとして扱う
+func (r *mockUserRepository) FindByEmail(ctx context.Context, ex domain.Executor, email string) (*domain.User, error) {
+	return nil, domain.ErrUserNotFound
+}
+
+func TestTaskHandler_ImportTasks(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantCode    string
+		wantTotal   int
+		wantValid   int
+		wantErrors  []handler.ImportRowError
+	}{
+		{
+			name:        "CSVはヘッダーで列を対応付け、行番号はヘッダーを1行目として数える",
+			contentType: "text/csv; charset=utf-8",
+			body: "\ufeffTitle, Due_Date ,priority,Assignee Email,memo\n" +
+				"'=1+1,2025-01-10,3,,無視する列\n" +
+				"期日が不正,tomorrow,,,\n" +
+				"担当者が不正,,,nobody@example.com\n" +
+				"\"改行を含む\n説明\",,9,,\n",
+			wantStatus: http.StatusUnprocessableEntity,
+			wantTotal:  4,
+			wantValid:  1,
+			wantErrors: []handler.ImportRowError{
+				{Line: 3, Field: "dueDate"},
+				{Line: 4, Field: "assigneeEmail"},
+				{Line: 5, Field: "priority"},
+			},
+		},
+		{
+			name:        "CSVの別名の列も受け付ける",
+			contentType: "text/csv",
+			body:        "title,due,assignee,status\nタスク,2025-01-10T09:00:00Z,,done\n",
+			wantStatus:  http.StatusOK,
+			wantTotal:   1,
+			wantValid:   1,
+		},
+		{
+			name:        "CSVにtitle列がなければ400",
+			contentType: "text/csv",
+			body:        "name,priority\nタスク,1\n",
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "INVALID_REQUEST",
+		},
+		{
+			name:        "空のCSVは400",
+			contentType: "text/csv",
+			body:        "",
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "INVALID_REQUEST",
+		},
+		{
+			name:        "JSONの行番号は配列の1始まりの添字",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `[{"title":"タスク","priority":3,"dueDate":"2025-01-10"},{"title":"","status":"BLOCKED"},{"title":"優先度","priority":6}]`,
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantTotal:   3,
+			wantValid:   1,
+			wantErrors: []handler.ImportRowError{
+				{Line: 2, Field: "title"},
+				{Line: 2, Field: "status"},
+				{Line: 3, Field: "priority"},
+			},
+		},
+		{
+			name:        "JSONが配列でなければ400",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"title":"タスク"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "INVALID_REQUEST",
+		},
+		{
+			name:        "空の配列は400",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `[]`,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "VALIDATION_ERROR",
+		},
+		{
+			name:        "CSVとJSON以外は415",
+			contentType: "text/plain",
+			body:        "title\nタスク\n",
+			wantStatus:  http.StatusUnsupportedMediaType,
+			wantCode:    "UNSUPPORTED_MEDIA_TYPE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := &mockTaskRepository{}
+			h := newTaskHandler(taskRepo)
+
+			rec := serveImport(h, tt.contentType, tt.body)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			if tt.wantCode != "" {
+				var body handler.ErrorResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatalf("invalid json: %v", err)
+				}
+				if body.Code != tt.wantCode {
+					t.Errorf("code = %s, want %s", body.Code, tt.wantCode)
+				}
+				return
+			}
+
+			var body handler.ImportTasksResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid json: %v", err)
+			}
+			if !body.DryRun || body.Total != tt.wantTotal || body.Valid != tt.wantValid || body.Imported != 0 {
+				t.Errorf("DryRun = %v, Total = %d, Valid = %d, Imported = %d, want true, %d, %d, 0", body.DryRun, body.Total, body.Valid, body.Imported, tt.wantTotal, tt.wantValid)
+			}
+			if len(body.Errors) != len(tt.wantErrors) {
+				t.Fatalf("errors = %+v, want %+v", body.Errors, tt.wantErrors)
+			}
+			for i, want := range tt.wantErrors {
+				if got := body.Errors[i]; got.Line != want.Line || got.Field != want.Field {
+					t.Errorf("errors[%d] = %+v, want line %d field %s", i, got, want.Line, want.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskHandler_ImportTasks_BodyTooLarge(t *testing.T) {
+	h := newTaskHandler(&mockTaskRepository{})
+
+	// 5MBを超えたボディは途中で切り詰めて取り込まずに拒否する
+	body := "title\n" + strings.Repeat("a", 5<<20)
+	rec := serveImport(h, "text/csv", body)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if !strings.Contains(rec.Body.String(), "PAYLOAD_TOO_LARGE") {
+		t.Errorf("body = %s, want PAYLOAD_TOO_LARGE", rec.Body.String())
+	}
+}
+
+// serveImportはdry-runのインポートをオーナーとして処理する
+func serveImport(h *handler.TaskHandler, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/import?dryRun=true", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("userID", int64(ownerID))
+	if err := h.ImportTasks(c); err != nil {
+		panic(err)
+	}
+	return rec
+}
@@ -0,0 +1,44 @@
+package middleware_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ryusuke/task_app_layerx/internal/presentation/middleware"
+	idempotencyuc "github.com/ryusuke/task_app_layerx/internal/usecase/idempotency"
+)
+
+func TestIdempotencyMiddleware_BodyTooLarge(t *testing.T) {
+	// 上限を超えたボディはキーを登録する前に拒否するため、リポジトリは使わない
+	idempotencyUseCase := idempotencyuc.NewIdempotencyUseCase(nil, nil, nil, time.Hour)
+
+	called := false
+	h := middleware.IdempotencyMiddleware(idempotencyUseCase)(func(c echo.Context) error {
+		called = true
+		return c.NoContent(http.StatusCreated)
+	})
+
+	body := bytes.Repeat([]byte("a"), 5<<20+1)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/import", bytes.NewReader(body))
+	req.Header.Set("Idempotency-Key", "key")
+	rec := httptest.NewRecorder()
+
+	if err := h(echo.New().NewContext(req, rec)); err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if !strings.Contains(rec.Body.String(), "PAYLOAD_TOO_LARGE") {
+		t.Errorf("body = %s, want PAYLOAD_TOO_LARGE", rec.Body.String())
+	}
+	if called {
+		t.Error("handler must not be called for an oversized body")
+	}
+}
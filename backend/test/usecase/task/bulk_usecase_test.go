@@ -0,0 +1,292 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+type mockClock struct {
+	now time.Time
+}
+
+func (c *mockClock) Now() time.Time {
+	return c.now
+}
+
+// memoryStoreはトランザクションのロールバックを再現するためのインメモリのデータ
+type memoryStore struct {
+	tasks     map[int64]domain.Task
+	revisions int
+}
+
+// mockTxManagerはfnがエラーを返した場合にデータをfn実行前の状態に戻す
+type mockTxManager struct {
+	store *memoryStore
+}
+
+func (m *mockTxManager) Do(ctx context.Context, fn func(context.Context, domain.Executor) error) error {
+	tasks := maps.Clone(m.store.tasks)
+	revisions := m.store.revisions
+	if err := fn(ctx, nil); err != nil {
+		m.store.tasks = tasks
+		m.store.revisions = revisions
+		return err
+	}
+	return nil
+}
+
+func (m *mockTxManager) AsExecutor() domain.Executor {
+	return nil
+}
+
+// mockTaskRepositoryはconflictIDsのタスクの更新を同時更新による競合として失敗させる
+type mockTaskRepository struct {
+	domain.TaskRepository
+	store       *memoryStore
+	conflictIDs map[int64]bool
+}
+
+func (r *mockTaskRepository) FindByID(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	task, ok := r.store.tasks[taskID]
+	if !ok {
+		return nil, domain.ErrTaskNotFound
+	}
+	return &task, nil
+}
+
+func (r *mockTaskRepository) FindByIDForUpdate(ctx context.Context, ex domain.Executor, taskID int64) (*domain.Task, error) {
+	return r.FindByID(ctx, ex, taskID)
+}
+
+func (r *mockTaskRepository) Update(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	if r.conflictIDs[task.ID] {
+		return domain.ErrTaskVersionMismatch
+	}
+	task.Version++
+	r.store.tasks[task.ID] = *task
+	return nil
+}
+
+type mockTaskAssigneeRepository struct {
+	domain.TaskAssigneeRepository
+}
+
+func (r *mockTaskAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskAssignee, error) {
+	return nil, nil
+}
+
+type mockTaskGroupAssigneeRepository struct {
+	domain.TaskGroupAssigneeRepository
+}
+
+func (r *mockTaskGroupAssigneeRepository) FindByTaskID(ctx context.Context, ex domain.Executor, taskID int64) ([]*domain.TaskGroupAssignee, error) {
+	return nil, nil
+}
+
+type mockTaskRevisionRepository struct {
+	domain.TaskRevisionRepository
+	store *memoryStore
+}
+
+func (r *mockTaskRevisionRepository) Create(ctx context.Context, ex domain.Executor, revision *domain.TaskRevision) error {
+	r.store.revisions++
+	return nil
+}
+
+type mockTaskStatusTransitionRepository struct {
+	domain.TaskStatusTransitionRepository
+}
+
+func (r *mockTaskStatusTransitionRepository) Create(ctx context.Context, ex domain.Executor, transition *domain.TaskStatusTransition) error {
+	return nil
+}
+
+func newBulkTestUseCase(store *memoryStore, conflictIDs map[int64]bool) *taskuc.TaskUseCase {
+	return taskuc.NewTaskUseCase(
+		&mockTaskRepository{store: store, conflictIDs: conflictIDs},
+		&mockTaskAssigneeRepository{},
+		&mockTaskGroupAssigneeRepository{},
+		nil, nil, nil, nil, nil, nil,
+		&mockTaskRevisionRepository{store: store},
+		&mockTaskStatusTransitionRepository{},
+		nil,
+		&mockTxManager{store: store},
+		&mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		domain.UnverifiedUserPolicyNone,
+	)
+}
+
+func TestTaskUseCase_BulkUpdateTasks_ApplyFailure(t *testing.T) {
+	const ownerID = 1
+	priority := 3
+
+	tests := []struct {
+		name          string
+		mode          taskuc.BulkMode
+		wantErr       bool
+		wantSucceeded int
+		wantFailed    int
+		wantPriority  map[int64]int
+		wantRevisions int
+	}{
+		{
+			name:          "best_effortでは競合したタスクだけを失敗として返し、他のタスクは適用する",
+			mode:          taskuc.BulkModeBestEffort,
+			wantSucceeded: 2,
+			wantFailed:    1,
+			wantPriority:  map[int64]int{1: priority, 2: 0, 3: priority},
+			wantRevisions: 2,
+		},
+		{
+			name:          "atomicでは競合したらエラーを返し、何も適用しない",
+			mode:          taskuc.BulkModeAtomic,
+			wantErr:       true,
+			wantPriority:  map[int64]int{1: 0, 2: 0, 3: 0},
+			wantRevisions: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{tasks: map[int64]domain.Task{}}
+			for id := int64(1); id <= 3; id++ {
+				store.tasks[id] = domain.Task{ID: id, OwnerID: ownerID, Title: "task", Status: domain.TaskStatusTODO, Version: 1}
+			}
+			uc := newBulkTestUseCase(store, map[int64]bool{2: true})
+
+			res, err := uc.BulkUpdateTasks(context.Background(), ownerID, taskuc.BulkTaskRequest{
+				TaskIDs:  []int64{1, 2, 3},
+				Mode:     tt.mode,
+				Priority: &priority,
+			})
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrTaskVersionMismatch) {
+					t.Fatalf("BulkUpdateTasks() error = %v, want ErrTaskVersionMismatch", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("BulkUpdateTasks() error = %v", err)
+				}
+				if res.Succeeded != tt.wantSucceeded || res.Failed != tt.wantFailed || !res.Applied {
+					t.Errorf("Succeeded = %d, Failed = %d, Applied = %v, want %d, %d, true", res.Succeeded, res.Failed, res.Applied, tt.wantSucceeded, tt.wantFailed)
+				}
+				for _, result := range res.Results {
+					wantConflict := result.TaskID == 2
+					if result.Success == wantConflict || wantConflict != errors.Is(result.Err, domain.ErrTaskVersionMismatch) {
+						t.Errorf("task %d: Success = %v, Err = %v", result.TaskID, result.Success, result.Err)
+					}
+				}
+			}
+
+			for id, want := range tt.wantPriority {
+				if got := store.tasks[id].Priority; got != want {
+					t.Errorf("task %d priority = %d, want %d", id, got, want)
+				}
+			}
+			if store.revisions != tt.wantRevisions {
+				t.Errorf("revisions = %d, want %d", store.revisions, tt.wantRevisions)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_BulkUpdateTasks_ValidationFailure(t *testing.T) {
+	const ownerID = 1
+	status := string(domain.TaskStatusDONE)
+	priority := 3
+
+	tests := []struct {
+		name    string
+		mode    taskuc.BulkMode
+		task2   domain.Task
+		wantErr error
+		// wantAppliedは検証に成功したタスク（1と3）に変更が適用されるか
+		wantApplied bool
+	}{
+		{
+			name:    "atomicでは他人のタスクが1件あると、他のタスクも中止して何も適用しない",
+			mode:    taskuc.BulkModeAtomic,
+			task2:   domain.Task{ID: 2, OwnerID: 2, Title: "task", Status: domain.TaskStatusTODO, Version: 1},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "atomicでは不正なステータス遷移が1件あると、他のタスクも中止して何も適用しない",
+			mode:    taskuc.BulkModeAtomic,
+			task2:   domain.Task{ID: 2, OwnerID: ownerID, Title: "task", Status: domain.TaskStatusDONE, Version: 1},
+			wantErr: domain.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "best_effortでは検証に失敗したタスク以外を適用する",
+			mode:        taskuc.BulkModeBestEffort,
+			task2:       domain.Task{ID: 2, OwnerID: 2, Title: "task", Status: domain.TaskStatusTODO, Version: 1},
+			wantErr:     domain.ErrForbidden,
+			wantApplied: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{tasks: map[int64]domain.Task{
+				1: {ID: 1, OwnerID: ownerID, Title: "task", Status: domain.TaskStatusTODO, Version: 1},
+				2: tt.task2,
+				3: {ID: 3, OwnerID: ownerID, Title: "task", Status: domain.TaskStatusTODO, Version: 1},
+			}}
+			uc := newBulkTestUseCase(store, nil)
+
+			res, err := uc.BulkUpdateTasks(context.Background(), ownerID, taskuc.BulkTaskRequest{
+				TaskIDs:  []int64{1, 2, 3},
+				Mode:     tt.mode,
+				Status:   &status,
+				Priority: &priority,
+			})
+			if err != nil {
+				t.Fatalf("BulkUpdateTasks() error = %v", err)
+			}
+
+			// 検証に成功したタスクは、atomicでは中止（ErrBulkAborted）、best_effortでは成功として返す
+			wantSucceeded, wantFailed := 0, 3
+			if tt.wantApplied {
+				wantSucceeded, wantFailed = 2, 1
+			}
+			if res.Succeeded != wantSucceeded || res.Failed != wantFailed || res.Applied != tt.wantApplied {
+				t.Errorf("Succeeded = %d, Failed = %d, Applied = %v, want %d, %d, %v", res.Succeeded, res.Failed, res.Applied, wantSucceeded, wantFailed, tt.wantApplied)
+			}
+			for _, result := range res.Results {
+				var wantErr error
+				switch {
+				case result.TaskID == 2:
+					wantErr = tt.wantErr
+				case !tt.wantApplied:
+					wantErr = domain.ErrBulkAborted
+				}
+				if result.Success != (wantErr == nil) || !errors.Is(result.Err, wantErr) {
+					t.Errorf("task %d: Success = %v, Err = %v, want Err %v", result.TaskID, result.Success, result.Err, wantErr)
+				}
+			}
+
+			for _, id := range []int64{1, 3} {
+				task := store.tasks[id]
+				applied := task.Priority == priority && task.Status == domain.TaskStatusDONE && task.Version == 2
+				if applied != tt.wantApplied {
+					t.Errorf("task %d: Priority = %d, Status = %s, Version = %d, want applied = %v", id, task.Priority, task.Status, task.Version, tt.wantApplied)
+				}
+			}
+			if store.tasks[2] != tt.task2 {
+				t.Errorf("task 2 = %+v, want unchanged", store.tasks[2])
+			}
+			wantRevisions := 0
+			if tt.wantApplied {
+				wantRevisions = 2
+			}
+			if store.revisions != wantRevisions {
+				t.Errorf("revisions = %d, want %d", store.revisions, wantRevisions)
+			}
+		})
+	}
+}
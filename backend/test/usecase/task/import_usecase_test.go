@@ -0,0 +1,203 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryusuke/task_app_layerx/internal/domain"
+	taskuc "github.com/ryusuke/task_app_layerx/internal/usecase/task"
+)
+
+func (r *mockTaskRepository) Create(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	task.ID = int64(len(r.store.tasks) + 1)
+	r.store.tasks[task.ID] = *task
+	return nil
+}
+
+type mockSearchIndex struct {
+	domain.TaskSearchIndex
+}
+
+func (i *mockSearchIndex) Index(ctx context.Context, ex domain.Executor, task *domain.Task) error {
+	return nil
+}
+
+// mockUserRepositoryはどのメールアドレスのユーザーも存在しないものとして扱う
+type mockUserRepository struct {
+	domain.UserRepository
+}
+
+func (r *mockUserRepository) FindByEmail(ctx context.Context, ex domain.Executor, email string) (*domain.User, error) {
+	return nil, domain.ErrUserNotFound
+}
+
+func newImportTestUseCase(store *memoryStore) *taskuc.TaskUseCase {
+	return taskuc.NewTaskUseCase(
+		&mockTaskRepository{store: store},
+		&mockTaskAssigneeRepository{},
+		&mockTaskGroupAssigneeRepository{},
+		nil, nil, nil, nil, nil,
+		&mockSearchIndex{},
+		&mockTaskRevisionRepository{store: store},
+		&mockTaskStatusTransitionRepository{},
+		&mockUserRepository{},
+		&mockTxManager{store: store},
+		&mockClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		domain.UnverifiedUserPolicyNone,
+	)
+}
+
+func TestTaskUseCase_ImportTasks(t *testing.T) {
+	const ownerID = 1
+
+	validRows := []taskuc.ImportTaskRow{
+		{Line: 2, Title: "終日の期日", DueDate: "2025-01-10", Priority: "3", Status: "in_progress"},
+		{Line: 3, Title: "日時の期日", Description: "説明", DueDate: "2025-01-10T09:00:00+09:00"},
+	}
+
+	tests := []struct {
+		name          string
+		req           taskuc.ImportTasksRequest
+		wantValid     int
+		wantImported  int
+		wantErrors    []taskuc.ImportRowError
+		wantRevisions int
+	}{
+		{
+			name:          "すべての行が正しければ登録する",
+			req:           taskuc.ImportTasksRequest{Rows: validRows},
+			wantValid:     2,
+			wantImported:  2,
+			wantRevisions: 2,
+		},
+		{
+			name:      "dry-runでは検証だけ行い何も書き込まない",
+			req:       taskuc.ImportTasksRequest{Rows: validRows, DryRun: true},
+			wantValid: 2,
+		},
+		{
+			name: "エラーのある行は行番号と項目を報告し、正しい行も含めて何も書き込まない",
+			req: taskuc.ImportTasksRequest{Rows: append([]taskuc.ImportTaskRow{
+				{Line: 4, Title: " "},
+				{Line: 5, Title: "不正な値", DueDate: "tomorrow", Priority: "high", Status: "BLOCKED", AssigneeEmail: "nobody@example.com"},
+				{Line: 6, Title: "範囲外の優先度", Priority: "6"},
+			}, validRows...)},
+			wantValid: 2,
+			wantErrors: []taskuc.ImportRowError{
+				{Line: 4, Field: "title"},
+				{Line: 5, Field: "dueDate"},
+				{Line: 5, Field: "priority"},
+				{Line: 5, Field: "status"},
+				{Line: 5, Field: "assigneeEmail"},
+				{Line: 6, Field: "priority"},
+			},
+		},
+		{
+			name: "dry-runでもエラーを報告する",
+			req: taskuc.ImportTasksRequest{Rows: []taskuc.ImportTaskRow{
+				{Line: 1, Title: "ok"},
+				{Line: 2, Title: ""},
+			}, DryRun: true},
+			wantValid:  1,
+			wantErrors: []taskuc.ImportRowError{{Line: 2, Field: "title"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{tasks: map[int64]domain.Task{}}
+			uc := newImportTestUseCase(store)
+
+			res, err := uc.ImportTasks(context.Background(), ownerID, tt.req)
+			if err != nil {
+				t.Fatalf("ImportTasks() error = %v", err)
+			}
+
+			if res.DryRun != tt.req.DryRun || res.Total != len(tt.req.Rows) || res.Valid != tt.wantValid || res.Imported != tt.wantImported {
+				t.Errorf("DryRun = %v, Total = %d, Valid = %d, Imported = %d, want %v, %d, %d, %d",
+					res.DryRun, res.Total, res.Valid, res.Imported, tt.req.DryRun, len(tt.req.Rows), tt.wantValid, tt.wantImported)
+			}
+			if len(res.TaskIDs) != tt.wantImported {
+				t.Errorf("TaskIDs = %v, want %d ids", res.TaskIDs, tt.wantImported)
+			}
+
+			if len(res.Errors) != len(tt.wantErrors) {
+				t.Fatalf("Errors = %+v, want %+v", res.Errors, tt.wantErrors)
+			}
+			for i, want := range tt.wantErrors {
+				got := res.Errors[i]
+				if got.Line != want.Line || got.Field != want.Field || got.Message == "" {
+					t.Errorf("Errors[%d] = %+v, want line %d field %s", i, got, want.Line, want.Field)
+				}
+			}
+
+			if len(store.tasks) != tt.wantImported {
+				t.Errorf("stored tasks = %d, want %d", len(store.tasks), tt.wantImported)
+			}
+			if store.revisions != tt.wantRevisions {
+				t.Errorf("revisions = %d, want %d", store.revisions, tt.wantRevisions)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_ImportTasks_Values(t *testing.T) {
+	store := &memoryStore{tasks: map[int64]domain.Task{}}
+	uc := newImportTestUseCase(store)
+
+	_, err := uc.ImportTasks(context.Background(), 1, taskuc.ImportTasksRequest{Rows: []taskuc.ImportTaskRow{
+		{Line: 1, Title: " 終日の期日 ", DueDate: "2025-01-10", Priority: "3", Status: "in_progress"},
+		{Line: 2, Title: "日時の期日", Description: "説明", DueDate: "2025-01-10T09:00:00+09:00"},
+	}})
+	if err != nil {
+		t.Fatalf("ImportTasks() error = %v", err)
+	}
+
+	allDay := store.tasks[1]
+	if allDay.Title != "終日の期日" || allDay.Priority != 3 || allDay.Status != domain.TaskStatusIN_PROGRESS || allDay.OwnerID != 1 {
+		t.Errorf("task 1 = %+v", allDay)
+	}
+	if !allDay.DueAllDay || allDay.DueDate == nil || !allDay.DueDate.Equal(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("task 1 DueDate = %v, DueAllDay = %v, want 2025-01-10 all day", allDay.DueDate, allDay.DueAllDay)
+	}
+
+	timed := store.tasks[2]
+	if timed.Description == nil || *timed.Description != "説明" || timed.Status != domain.TaskStatusTODO {
+		t.Errorf("task 2 = %+v", timed)
+	}
+	if timed.DueAllDay || timed.DueDate == nil || !timed.DueDate.Equal(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("task 2 DueDate = %v, DueAllDay = %v, want 2025-01-10T00:00:00Z", timed.DueDate, timed.DueAllDay)
+	}
+}
+
+func TestTaskUseCase_ImportTasks_RowCount(t *testing.T) {
+	tests := []struct {
+		name string
+		rows int
+	}{
+		{name: "行がない場合はエラー", rows: 0},
+		{name: "1000行を超える場合はエラー", rows: 1001},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{tasks: map[int64]domain.Task{}}
+			uc := newImportTestUseCase(store)
+
+			rows := make([]taskuc.ImportTaskRow, tt.rows)
+			for i := range rows {
+				rows[i] = taskuc.ImportTaskRow{Line: i + 1, Title: "task"}
+			}
+
+			_, err := uc.ImportTasks(context.Background(), 1, taskuc.ImportTasksRequest{Rows: rows})
+			if !errors.Is(err, domain.ErrInvalidImport) {
+				t.Errorf("ImportTasks() error = %v, want ErrInvalidImport", err)
+			}
+			if len(store.tasks) != 0 {
+				t.Errorf("stored tasks = %d, want 0", len(store.tasks))
+			}
+		})
+	}
+}
@@ -13,6 +13,13 @@ function App() {
   const [password, setPassword] = useState('');
   const [name, setName] = useState('');
 
+  // パスワード再設定リンク（/reset-password?token=...）から開かれた場合のトークン
+  const [resetToken, setResetToken] = useState<string | null>(() =>
+    window.location.pathname === '/reset-password'
+      ? new URLSearchParams(window.location.search).get('token')
+      : null
+  );
+
   // Task form
   const [title, setTitle] = useState('');
   const [description, setDescription] = useState('');
@@ -43,6 +50,26 @@ function App() {
       loadTasks();
       loadUsers();
     }
+
+    // メールアドレス確認リンク（/verify-email?token=...）から開かれた場合
+    const verifyToken = window.location.pathname === '/verify-email'
+      ? new URLSearchParams(window.location.search).get('token')
+      : null;
+    if (verifyToken) {
+      window.history.replaceState(null, '', '/');
+      api.verifyEmail(verifyToken)
+        .then(() => {
+          alert('メールアドレスを確認しました。');
+          setUser((current) => {
+            if (!current) return current;
+            const verified = { ...current, emailVerified: true };
+            localStorage.setItem('user', JSON.stringify(verified));
+            return verified;
+          });
+          if (token) loadUsers();
+        })
+        .catch((error) => alert(`メールアドレスの確認に失敗しました。\n\n${getErrorMessage(error)}`));
+    }
   }, []);
 
   const loadTasks = async () => {
@@ -70,7 +97,16 @@ function App() {
         ? await api.login(email, password)
         : await api.signup(email, password, name);
 
+      // メールアドレスを確認するまでログインできない設定の場合はトークンが返らない
+      if (!response.token || !response.refreshToken) {
+        alert('確認メールを送信しました。メールのリンクを開いてから、ログインしてください。');
+        setShowLogin(true);
+        setPassword('');
+        return;
+      }
+
       localStorage.setItem('token', response.token);
+      localStorage.setItem('refreshToken', response.refreshToken);
       localStorage.setItem('user', JSON.stringify(response.user));
       setUser(response.user);
       loadTasks();
@@ -84,9 +120,47 @@ function App() {
     }
   };
 
+  const handleForgotPassword = async () => {
+    if (!email) {
+      alert('メールアドレスを入力してください。');
+      return;
+    }
+    try {
+      await api.forgotPassword(email);
+      alert('登録済みのメールアドレスであれば、パスワード再設定用のリンクを送信しました。');
+    } catch (error) {
+      alert(`再設定のリクエストに失敗しました。\n\n${getErrorMessage(error)}`);
+    }
+  };
+
+  const handleResetPassword = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!resetToken) return;
+    try {
+      await api.resetPassword(resetToken, password);
+      alert('パスワードを再設定しました。新しいパスワードでログインしてください。');
+      window.history.replaceState(null, '', '/');
+      setResetToken(null);
+      setShowLogin(true);
+      setPassword('');
+    } catch (error) {
+      alert(`パスワードの再設定に失敗しました。\n\n${getErrorMessage(error)}`);
+    }
+  };
+
+  const handleResendVerification = async (address: string) => {
+    try {
+      await api.resendVerificationEmail(address);
+      alert('確認メールを再送しました。');
+    } catch (error) {
+      alert(`確認メールの再送に失敗しました。\n\n${getErrorMessage(error)}`);
+    }
+  };
+
   const handleLogout = async () => {
     await api.logout();
     localStorage.removeItem('token');
+    localStorage.removeItem('refreshToken');
     localStorage.removeItem('user');
     setUser(null);
     setTasks([]);
@@ -134,6 +208,28 @@ function App() {
     }
   };
 
+  if (!user && resetToken) {
+    return (
+      <div className="container">
+        <h1>Task Manager</h1>
+        <div className="auth-container">
+          <h2>パスワードの再設定</h2>
+          <form onSubmit={handleResetPassword}>
+            <input
+              type="password"
+              placeholder="New password"
+              value={password}
+              onChange={(e) => setPassword(e.target.value)}
+              required
+              minLength={8}
+            />
+            <button type="submit">Reset password</button>
+          </form>
+        </div>
+      </div>
+    );
+  }
+
   if (!user) {
     return (
       <div className="container">
@@ -180,6 +276,16 @@ function App() {
             />
             <button type="submit">{showLogin ? 'Login' : 'Signup'}</button>
           </form>
+          {showLogin && (
+            <>
+              <button type="button" onClick={handleForgotPassword}>
+                パスワードを忘れた場合
+              </button>
+              <button type="button" onClick={() => email ? handleResendVerification(email) : alert('メールアドレスを入力してください。')}>
+                確認メールを再送
+              </button>
+            </>
+          )}
         </div>
       </div>
     );
@@ -195,6 +301,13 @@ function App() {
         </div>
       </header>
 
+      {!user.emailVerified && (
+        <div className="verify-banner">
+          メールアドレスが確認されていません。{user.email} に送信したメールのリンクを開いてください。
+          <button onClick={() => handleResendVerification(user.email)}>確認メールを再送</button>
+        </div>
+      )}
+
       <div className="task-form">
         <h2>Create New Task</h2>
         <form onSubmit={handleCreateTask}>
@@ -234,7 +347,7 @@ function App() {
                       }
                     }}
                   />
-                  {u.name} ({u.email})
+                  {u.name} ({u.email}){!u.emailVerified && ' ※メール未確認'}
                 </label>
               ))
             )}
@@ -4,13 +4,27 @@ export interface User {
   id: number;
   email: string;
   name: string;
+  emailVerified: boolean;
 }
 
+// メールアドレス未確認のユーザーがログインできない設定での登録時は、トークンを含まない
 export interface AuthResponse {
-  token: string;
+  token?: string;
+  refreshToken?: string;
   user: User;
 }
 
+export interface Session {
+  id: number;
+  device: string;
+  userAgent: string;
+  ipAddress: string;
+  createdAt: string;
+  lastUsedAt: string;
+  expiresAt: string;
+  current: boolean;
+}
+
 export interface Assignee {
   userId: number;
   assignedBy: number;
@@ -22,14 +36,44 @@ export interface Task {
   ownerId: number;
   title: string;
   description: string;
+  descriptionHtml: string | null;
   dueDate: string | null;
   status: 'TODO' | 'IN_PROGRESS' | 'DONE';
   priority: number;
   assignees: Assignee[];
+  version: number;
   createdAt: string;
   updatedAt: string;
 }
 
+export interface TaskList {
+  items: Task[];
+  nextCursor: string | null;
+  total?: number;
+}
+
+export interface QuickAddResult {
+  parsed: {
+    title: string;
+    dueDate: string | null;
+    dueAllDay: boolean;
+    priority: number | null;
+    assignees: { mention: string; userId: number | null }[];
+    labels: string[];
+  };
+  task: Task | null;
+}
+
+export interface TaskStats {
+  total: number;
+  byStatus: Record<Task['status'], number>;
+  byPriority: Record<string, number>;
+  overdue: number;
+  dueThisWeek: number;
+  owned: number;
+  assigned: number;
+}
+
 export interface ApiError {
   code: string;
   message: string;
@@ -54,6 +98,9 @@ export const ERROR_MESSAGES: Record<string, string> = {
   UNAUTHORIZED: '認証が必要です。ログインしてください。',
   INVALID_TOKEN: 'トークンが無効または期限切れです。再度ログインしてください。',
   TOKEN_EXPIRED: 'セッションが期限切れです。再度ログインしてください。',
+  INVALID_RESET_TOKEN: '再設定リンクが無効または期限切れです。もう一度再設定をリクエストしてください。',
+  INVALID_VERIFICATION_TOKEN: '確認リンクが無効または期限切れです。確認メールを再送してください。',
+  EMAIL_NOT_VERIFIED: 'メールアドレスが確認されていません。確認メールのリンクを開いてください。',
   
   // 認可エラー
   FORBIDDEN: 'この操作を実行する権限がありません。',
@@ -65,6 +112,7 @@ export const ERROR_MESSAGES: Record<string, string> = {
   VALIDATION_ERROR: '入力内容に誤りがあります。',
   INVALID_REQUEST: 'リクエストの形式が正しくありません。',
   INVALID_DATE_FORMAT: '日付の形式が正しくありません（ISO8601形式で入力してください）。',
+  ASSIGNEE_NOT_VERIFIED: 'メールアドレスが確認されていないユーザーはアサインできません。',
   
   // 重複エラー
   CONFLICT: 'すでに存在するデータです。',
@@ -82,6 +130,56 @@ class ApiClient {
     };
   }
 
+  private getVersionedHeaders(version?: number): HeadersInit {
+    return {
+      ...this.getHeaders(),
+      ...(version !== undefined && { 'If-Match': `"${version}"` }),
+    };
+  }
+
+  // 同時に複数のリクエストが期限切れになっても再発行は1回にする
+  private refreshing: Promise<boolean> | null = null;
+
+  // アクセストークンの期限切れ（401 TOKEN_EXPIRED）の場合はリフレッシュトークンで再発行して1回だけ再試行する
+  private async fetchWithAuth(url: string, init: RequestInit): Promise<Response> {
+    const response = await fetch(url, init);
+    if (response.status !== 401 || !localStorage.getItem('refreshToken')) {
+      return response;
+    }
+    const error: ApiError | null = await response.clone().json().catch(() => null);
+    if (error?.code !== 'TOKEN_EXPIRED' || !(await this.refreshTokens())) {
+      return response;
+    }
+    return fetch(url, {
+      ...init,
+      headers: { ...(init.headers as Record<string, string>), ...(this.getHeaders() as Record<string, string>) },
+    });
+  }
+
+  private refreshTokens(): Promise<boolean> {
+    if (!this.refreshing) {
+      this.refreshing = (async () => {
+        try {
+          const response = await fetch(`${API_BASE_URL}/auth/refresh`, {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({ refreshToken: localStorage.getItem('refreshToken') }),
+          });
+          if (!response.ok) {
+            return false;
+          }
+          const data: AuthResponse = await response.json();
+          localStorage.setItem('token', data.token);
+          localStorage.setItem('refreshToken', data.refreshToken);
+          return true;
+        } finally {
+          this.refreshing = null;
+        }
+      })();
+    }
+    return this.refreshing;
+  }
+
   private async handleResponse<T>(response: Response): Promise<T> {
     if (!response.ok) {
       try {
@@ -97,8 +195,8 @@ class ApiClient {
       }
     }
     
-    // 204 No Content の場合
-    if (response.status === 204) {
+    // 202 Accepted / 204 No Content の場合（本文なし）
+    if (response.status === 202 || response.status === 204) {
       return undefined as T;
     }
     
@@ -123,30 +221,108 @@ class ApiClient {
     return this.handleResponse<AuthResponse>(response);
   }
 
+  async forgotPassword(email: string): Promise<void> {
+    const response = await fetch(`${API_BASE_URL}/auth/password/forgot`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ email }),
+    });
+    return this.handleResponse<void>(response);
+  }
+
+  async resetPassword(token: string, password: string): Promise<void> {
+    const response = await fetch(`${API_BASE_URL}/auth/password/reset`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ token, password }),
+    });
+    return this.handleResponse<void>(response);
+  }
+
+  async verifyEmail(token: string): Promise<void> {
+    const response = await fetch(`${API_BASE_URL}/auth/email/verify`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ token }),
+    });
+    return this.handleResponse<void>(response);
+  }
+
+  async resendVerificationEmail(email: string): Promise<void> {
+    const response = await fetch(`${API_BASE_URL}/auth/email/resend`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ email }),
+    });
+    return this.handleResponse<void>(response);
+  }
+
   async logout(): Promise<void> {
-    const response = await fetch(`${API_BASE_URL}/auth/logout`, {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/auth/logout`, {
       method: 'POST',
       headers: this.getHeaders(),
     });
     return this.handleResponse<void>(response);
   }
 
+  async getSessions(): Promise<Session[]> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/auth/sessions`, {
+      headers: this.getHeaders(),
+    });
+    return this.handleResponse<Session[]>(response);
+  }
+
+  async revokeSession(id: number): Promise<void> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/auth/sessions/${id}`, {
+      method: 'DELETE',
+      headers: this.getHeaders(),
+    });
+    return this.handleResponse<void>(response);
+  }
+
+  // 現在の端末以外からログアウトする
+  async revokeOtherSessions(): Promise<{ revoked: number }> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/auth/sessions/revoke-others`, {
+      method: 'POST',
+      headers: this.getHeaders(),
+    });
+    return this.handleResponse<{ revoked: number }>(response);
+  }
+
   async getUsers(): Promise<User[]> {
-    const response = await fetch(`${API_BASE_URL}/users`, {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/users`, {
       headers: this.getHeaders(),
     });
     return this.handleResponse<User[]>(response);
   }
 
   async getTasks(): Promise<Task[]> {
-    const response = await fetch(`${API_BASE_URL}/tasks`, {
+    // nextCursorがなくなるまで続きのページを取得する
+    const tasks: Task[] = [];
+    let cursor: string | null = null;
+    do {
+      const params = new URLSearchParams({ limit: '100' });
+      if (cursor) params.set('cursor', cursor);
+      const response = await this.fetchWithAuth(`${API_BASE_URL}/tasks?${params}`, {
+        headers: this.getHeaders(),
+      });
+      const page: TaskList = await this.handleResponse<TaskList>(response);
+      tasks.push(...page.items);
+      cursor = page.nextCursor;
+    } while (cursor);
+    return tasks;
+  }
+
+  // 件数はサーバー側で集計するため、全タスクを取得せずにダッシュボードを表示できる
+  async getStats(): Promise<TaskStats> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/stats`, {
       headers: this.getHeaders(),
     });
-    return this.handleResponse<Task[]>(response);
+    return this.handleResponse<TaskStats>(response);
   }
 
   async createTask(title: string, description: string, priority: number, assigneeIDs?: number[]): Promise<Task> {
-    const response = await fetch(`${API_BASE_URL}/tasks`, {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/tasks`, {
       method: 'POST',
       headers: this.getHeaders(),
       body: JSON.stringify({ title, description, priority, assigneeIDs }),
@@ -154,19 +330,31 @@ class ApiClient {
     return this.handleResponse<Task>(response);
   }
 
-  async updateTask(id: number, updates: Partial<Task>): Promise<Task> {
-    const response = await fetch(`${API_BASE_URL}/tasks/${id}`, {
-      method: 'PATCH',
+  // dryRunの場合は解析結果のみ返し、タスクは作成しない（入力中のプレビュー用）
+  async quickAddTask(input: string, dryRun = false): Promise<QuickAddResult> {
+    const params = dryRun ? '?dryRun=true' : '';
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/tasks/quick${params}`, {
+      method: 'POST',
       headers: this.getHeaders(),
+      body: JSON.stringify({ input }),
+    });
+    return this.handleResponse<QuickAddResult>(response);
+  }
+
+  // versionを渡すと、他の人が先に更新していた場合は412で失敗する
+  async updateTask(id: number, updates: Partial<Task>, version?: number): Promise<Task> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/tasks/${id}`, {
+      method: 'PATCH',
+      headers: this.getVersionedHeaders(version),
       body: JSON.stringify(updates),
     });
     return this.handleResponse<Task>(response);
   }
 
-  async deleteTask(id: number): Promise<void> {
-    const response = await fetch(`${API_BASE_URL}/tasks/${id}`, {
+  async deleteTask(id: number, version?: number): Promise<void> {
+    const response = await this.fetchWithAuth(`${API_BASE_URL}/tasks/${id}`, {
       method: 'DELETE',
-      headers: this.getHeaders(),
+      headers: this.getVersionedHeaders(version),
     });
     return this.handleResponse<void>(response);
   }